@@ -0,0 +1,9 @@
+# List the members and teams that are not linked to GitHub yet
+data "sentry_all_external_identities" "github" {
+  organization = "my-organization"
+  provider_key = "github"
+}
+
+output "unmapped_member_ids" {
+  value = data.sentry_all_external_identities.github.unmapped_member_ids
+}
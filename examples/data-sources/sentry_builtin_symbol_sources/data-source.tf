@@ -0,0 +1,11 @@
+# Retrieve the built-in symbol sources of an organization
+data "sentry_builtin_symbol_sources" "default" {
+  organization = "my-organization"
+}
+
+# Enable every built-in symbol source that is not hidden
+resource "sentry_project_builtin_symbol_sources" "default" {
+  organization = "my-organization"
+  project      = "native-app"
+  sources      = [for source in data.sentry_builtin_symbol_sources.default.sources : source.key if !source.hidden]
+}
@@ -0,0 +1,6 @@
+# Retrieve the visible environments of a project
+data "sentry_project_environments" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+  visibility   = "visible"
+}
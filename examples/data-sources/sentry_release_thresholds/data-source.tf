@@ -0,0 +1,5 @@
+# Retrieve the release thresholds of a project
+data "sentry_release_thresholds" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+}
@@ -0,0 +1,36 @@
+# Alert the owning team about new and regressed issues on all of its backend projects
+resource "sentry_alert_policy" "backend" {
+  organization = "my-organization"
+  name         = "New or regressed issue"
+
+  selector = {
+    teams        = ["my-team"]
+    slug_pattern = "backend-*"
+  }
+
+  template = {
+    action_match = "any"
+    frequency    = 30
+    owner        = "team:my-team"
+
+    conditions = <<EOT
+[
+  {
+    "id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"
+  },
+  {
+    "id": "sentry.rules.conditions.regression_event.RegressionEventCondition"
+  }
+]
+EOT
+
+    actions = <<EOT
+[
+  {
+    "id": "sentry.mail.actions.NotifyEmailAction",
+    "targetType": "IssueOwners"
+  }
+]
+EOT
+  }
+}
@@ -0,0 +1,17 @@
+# Mute an issue alert for everyone during a maintenance window
+resource "sentry_alert_snooze" "maintenance" {
+  organization   = sentry_issue_alert.main.organization
+  project        = sentry_issue_alert.main.project
+  issue_alert_id = sentry_issue_alert.main.id
+
+  until = "2024-01-02T06:00:00Z"
+}
+
+# Mute a metric alert indefinitely, only for the current user
+resource "sentry_alert_snooze" "mine" {
+  organization    = sentry_metric_alert.main.organization
+  project         = sentry_metric_alert.main.project
+  metric_alert_id = sentry_metric_alert.main.id
+
+  target = "me"
+}
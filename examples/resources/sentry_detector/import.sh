@@ -0,0 +1,3 @@
+# import using the organization, project slugs and detector id from the URL:
+# https://sentry.io/organizations/[org-slug]/monitors/[detector-id]/
+terraform import sentry_detector.default org-slug/project-slug/detector-id
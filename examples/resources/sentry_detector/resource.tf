@@ -0,0 +1,42 @@
+# Create an issue when the number of errors crosses a threshold
+resource "sentry_detector" "main" {
+  organization = sentry_project.main.organization
+  project      = sentry_project.main.id
+  name         = "High error count"
+  owner        = "team:${sentry_team.main.slug}"
+
+  data_source = {
+    query       = ""
+    aggregate   = "count()"
+    time_window = 3600 # 1 hour
+    dataset     = "events"
+    event_types = ["error"]
+  }
+
+  condition_group = {
+    logic_type = "any"
+    conditions = [
+      {
+        type             = "gt"
+        comparison       = jsonencode(300)
+        condition_result = jsonencode(75) # high priority
+      },
+      {
+        type             = "gt"
+        comparison       = jsonencode(100)
+        condition_result = jsonencode(50) # medium priority
+      },
+      {
+        type             = "lte"
+        comparison       = jsonencode(100)
+        condition_result = jsonencode(0) # resolve
+      },
+    ]
+  }
+}
+
+# Move an existing metric alert to a detector, keeping the migrated detector
+moved {
+  from = sentry_metric_alert.main
+  to   = sentry_detector.main
+}
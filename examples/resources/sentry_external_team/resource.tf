@@ -0,0 +1,16 @@
+# Retrieve the Slack organization integration
+data "sentry_organization_integration" "slack" {
+  organization = "my-organization"
+  provider_key = "slack"
+  name         = "my-slack-workspace"
+}
+
+# Send the notifications of a team to a Slack channel
+resource "sentry_external_team" "payments" {
+  organization   = "my-organization"
+  team           = "payments"
+  provider_key   = "slack"
+  external_name  = "#payments-alerts"
+  external_id    = "C0123456789"
+  integration_id = data.sentry_organization_integration.slack.id
+}
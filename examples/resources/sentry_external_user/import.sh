@@ -0,0 +1,2 @@
+# import using the organization slug, the member ID and the ID of the external user
+terraform import sentry_external_user.default org-slug/member-id/external-user-id
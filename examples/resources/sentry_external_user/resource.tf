@@ -0,0 +1,20 @@
+# Retrieve the GitHub organization integration
+data "sentry_organization_integration" "github" {
+  organization = "my-organization"
+  provider_key = "github"
+  name         = "my-github-organization"
+}
+
+data "sentry_organization_member" "jane" {
+  organization = "my-organization"
+  email        = "jane@example.com"
+}
+
+# Link a GitHub username to an organization member
+resource "sentry_external_user" "jane" {
+  organization   = "my-organization"
+  member_id      = data.sentry_organization_member.jane.id
+  provider_key   = "github"
+  external_name  = "@jane"
+  integration_id = data.sentry_organization_integration.github.id
+}
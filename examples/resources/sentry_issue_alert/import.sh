@@ -1,3 +1,6 @@
 # import using the organization, project slugs and rule id from the URL:
 # https://sentry.io/organizations/[org-slug]/alerts/rules/[project-slug]/[rule-id]/details/
 terraform import sentry_issue_alert.default org-slug/project-slug/rule-id
+
+# or using the rule name, which must be unique within the project:
+terraform import sentry_issue_alert.default "org-slug/project-slug/name:My rule name"
@@ -5,3 +5,22 @@ resource "sentry_key" "default" {
   project = "web-app"
   name    = "My Key"
 }
+
+# Create a key for a frontend using the JavaScript Loader Script
+resource "sentry_key" "frontend" {
+  organization = "my-organization"
+
+  project = "web-app"
+  name    = "Frontend"
+
+  javascript_loader_script = {
+    browser_sdk_version            = "8.x"
+    performance_monitoring_enabled = true
+    session_replay_enabled         = true
+    debug_enabled                  = false
+  }
+}
+
+output "frontend_loader_script_url" {
+  value = sentry_key.frontend.javascript_loader_script_url
+}
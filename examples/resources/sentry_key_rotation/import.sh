@@ -0,0 +1,3 @@
+# import using the organization, project slugs and the id of the current key from the URL:
+# https://sentry.io/settings/[org-slug]/projects/[project-slug]/keys/[key-id]/
+terraform import sentry_key_rotation.default org-slug/project-slug/key-id
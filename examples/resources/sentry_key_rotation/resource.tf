@@ -0,0 +1,23 @@
+# Rotate the client key of a project
+resource "sentry_key_rotation" "default" {
+  organization = "my-organization"
+
+  project = "web-app"
+  name    = "Web App"
+
+  # Change to rotate the key, e.g. after the DSN leaked
+  rotation_trigger = "2024-06-01"
+
+  # Move the previous key from "active" to "disabled" and then to "deleted"
+  # once every app uses the current key. Set it back to "active" when
+  # changing rotation_trigger.
+  previous_key_stage = "active"
+}
+
+output "dsn" {
+  value = sentry_key_rotation.default.current_key.dsn_public
+}
+
+output "previous_dsn" {
+  value = try(sentry_key_rotation.default.previous_key.dsn_public, null)
+}
@@ -4,3 +4,6 @@
 # or
 # https://sentry.io/organizations/[org-slug]/alerts/metric-rules/[project-slug]/[rule-id]/
 terraform import sentry_metric_alert.default org-slug/project-slug/rule-id
+
+# or using the rule name, which must be unique within the project:
+terraform import sentry_metric_alert.default "org-slug/project-slug/name:My rule name"
@@ -8,7 +8,7 @@ data "sentry_organization_integration" "github" {
 resource "sentry_project" "this" {
   organization = "my-organization"
 
-  team = "my-team"
+  teams = ["my-team"]
   name = "Web App"
   slug = "web-app"
 
@@ -0,0 +1,2 @@
+# import using the organization slug
+terraform import sentry_organization_data_scrubbing.default org-slug
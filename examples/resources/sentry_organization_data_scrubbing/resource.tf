@@ -0,0 +1,12 @@
+# Remove email addresses from the events of every project
+resource "sentry_organization_data_scrubbing" "default" {
+  organization = "my-organization"
+
+  rules = [
+    {
+      method = "remove"
+      type   = "email"
+      source = "$string"
+    },
+  ]
+}
@@ -0,0 +1,6 @@
+# Query the Microsoft and NVIDIA symbol servers for a native project
+resource "sentry_project_builtin_symbol_sources" "default" {
+  organization = "my-organization"
+  project      = "native-app"
+  sources      = ["microsoft", "nvidia"]
+}
@@ -0,0 +1,2 @@
+# import using the organization and project slugs, and the ID of the CODEOWNERS file
+terraform import sentry_project_codeowners.default org-slug/project-slug/codeowners-id
@@ -0,0 +1,7 @@
+# Use the CODEOWNERS file of a GitHub repository to assign issues
+resource "sentry_project_codeowners" "default" {
+  organization    = "my-organization"
+  project         = sentry_project.this.id
+  code_mapping_id = sentry_organization_code_mapping.this.id
+  raw             = file("${path.module}/.github/CODEOWNERS")
+}
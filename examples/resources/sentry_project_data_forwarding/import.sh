@@ -0,0 +1,4 @@
+# import using the organization and project slugs and the plugin ID, one of
+# segment, splunk, amazon-sqs or webhooks. Secrets are not imported and are set
+# on the next apply.
+terraform import sentry_project_data_forwarding.splunk org-slug/project-slug/splunk
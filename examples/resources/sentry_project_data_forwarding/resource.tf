@@ -0,0 +1,25 @@
+# Forward events to Splunk
+resource "sentry_project_data_forwarding" "splunk" {
+  organization = "my-organization"
+  project      = "web-app"
+
+  splunk = {
+    instance = "https://input-foo.cloud.splunk.com:8088"
+    index    = "sentry"
+    token    = var.splunk_token
+  }
+}
+
+# Forward events to an Amazon SQS queue, but keep forwarding paused
+resource "sentry_project_data_forwarding" "sqs" {
+  organization = "my-organization"
+  project      = "web-app"
+  enabled      = false
+
+  amazon_sqs = {
+    queue_url  = "https://sqs.us-east-1.amazonaws.com/123456789012/sentry-events"
+    region     = "us-east-1"
+    access_key = var.sqs_access_key
+    secret_key = var.sqs_secret_key
+  }
+}
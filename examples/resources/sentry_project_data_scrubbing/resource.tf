@@ -0,0 +1,20 @@
+# Scrub credit card numbers and custom tokens from the events of a project
+resource "sentry_project_data_scrubbing" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+
+  rules = [
+    {
+      method = "mask"
+      type   = "creditcard"
+      source = "$string"
+    },
+    {
+      method      = "replace"
+      type        = "pattern"
+      pattern     = "tok_[a-zA-Z0-9]+"
+      replacement = "[token]"
+      source      = "$http.headers.x-custom-token || $frame.vars"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# import using the organization and project slugs and the environment name
+terraform import sentry_project_environment.staging org-slug/project-slug/staging
@@ -0,0 +1,7 @@
+# Hide the staging environment from the environment selectors
+resource "sentry_project_environment" "staging" {
+  organization = "my-organization"
+  project      = "web-app"
+  name         = "staging"
+  is_hidden    = true
+}
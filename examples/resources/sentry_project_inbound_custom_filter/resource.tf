@@ -0,0 +1,9 @@
+# Discard noisy errors, old releases and internal traffic
+resource "sentry_project_inbound_custom_filter" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+
+  error_messages  = ["ChunkLoadError*", "ResizeObserver loop limit exceeded"]
+  releases        = ["0.*"]
+  blacklisted_ips = ["127.0.0.1", "10.0.0.0/8"]
+}
@@ -0,0 +1,2 @@
+# import using the organization and project slugs
+terraform import sentry_project_ownership.default org-slug/project-slug
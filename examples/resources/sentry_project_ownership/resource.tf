@@ -0,0 +1,32 @@
+# Ownership rules as structured rules
+resource "sentry_project_ownership" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+
+  rules = [
+    {
+      type    = "path"
+      pattern = "src/payments/*"
+      owners  = ["#payments", "jane@example.com"]
+    },
+    {
+      type    = "tags.sku_class"
+      pattern = "enterprise"
+      owners  = ["#enterprise"]
+    },
+  ]
+
+  fallthrough     = false
+  auto_assignment = "Auto Assign to Issue Owner"
+}
+
+# Ownership rules as raw text
+resource "sentry_project_ownership" "raw" {
+  organization = "my-organization"
+  project      = "mobile-app"
+
+  raw = <<-EOT
+    path:src/payments/* #payments
+    url:https://example.com/checkout/* #checkout
+  EOT
+}
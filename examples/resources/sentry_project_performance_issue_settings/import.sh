@@ -0,0 +1,3 @@
+# import using the organization and project slugs, then set the detectors to
+# manage in the configuration
+terraform import sentry_project_performance_issue_settings.default org-slug/project-slug
@@ -0,0 +1,19 @@
+# Tune the detectors of a service with a chatty ORM. The other detectors keep
+# the settings from the Sentry UI.
+resource "sentry_project_performance_issue_settings" "default" {
+  organization = "my-organization"
+  project      = "api"
+
+  n_plus_one_db_queries = {
+    enabled            = true
+    duration_threshold = 200
+  }
+
+  slow_db_queries = {
+    duration_threshold = 2000
+  }
+
+  consecutive_http_spans = {
+    enabled = false
+  }
+}
@@ -0,0 +1,22 @@
+# Keep 1% of health checks in production, and everything from a release
+# candidate for a week
+resource "sentry_project_sampling" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+
+  target_sample_rate = 0.2
+
+  rules = [
+    {
+      sample_rate  = 0.01
+      transactions = ["/health*", "/ready"]
+      environments = ["production"]
+    },
+    {
+      sample_rate = 1
+      releases    = ["2.0.0-rc*"]
+      start       = "2030-01-01T00:00:00Z"
+      end         = "2030-01-08T00:00:00Z"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# import using the organization and project slugs and the hook ID
+terraform import sentry_project_service_hook.default org-slug/project-slug/hook-id
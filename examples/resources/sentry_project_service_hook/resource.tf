@@ -0,0 +1,12 @@
+# Post new events of a project to an internal service
+resource "sentry_project_service_hook" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+  url          = "https://example.com/sentry-hook"
+  events       = ["event.created"]
+}
+
+output "service_hook_secret" {
+  value     = sentry_project_service_hook.default.secret
+  sensitive = true
+}
@@ -0,0 +1,16 @@
+# Grant an additional team access to a project
+resource "sentry_project" "default" {
+  organization = "my-organization"
+  teams        = ["my-team"]
+  name         = "Web App"
+  platform     = "javascript"
+
+  # Leave teams added by sentry_project_team alone
+  teams_authoritative = false
+}
+
+resource "sentry_project_team" "default" {
+  organization = sentry_project.default.organization
+  project      = sentry_project.default.id
+  team         = "my-other-team"
+}
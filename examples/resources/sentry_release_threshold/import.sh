@@ -0,0 +1,2 @@
+# import using the organization and project slugs and the threshold ID
+terraform import sentry_release_threshold.errors org-slug/project-slug/threshold-id
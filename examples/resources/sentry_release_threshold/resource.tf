@@ -0,0 +1,11 @@
+# Mark a release as unhealthy when more than 100 errors are seen in production
+# within an hour of it being deployed
+resource "sentry_release_threshold" "errors" {
+  organization      = "my-organization"
+  project           = "web-app"
+  threshold_type    = "total_error_count"
+  trigger_type      = "over"
+  value             = 100
+  window_in_seconds = 3600
+  environment       = "production"
+}
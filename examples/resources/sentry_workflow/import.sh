@@ -0,0 +1,3 @@
+# import using the organization slug and workflow id from the URL:
+# https://sentry.io/organizations/[org-slug]/monitors/alerts/[workflow-id]/
+terraform import sentry_workflow.default org-slug/workflow-id
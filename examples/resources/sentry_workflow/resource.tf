@@ -0,0 +1,44 @@
+# Notify the issue owners of new high error count issues
+resource "sentry_workflow" "main" {
+  organization = sentry_detector.main.organization
+  name         = "Notify issue owners"
+  frequency    = 30
+  detector_ids = [sentry_detector.main.id]
+
+  triggers = {
+    logic_type = "any-short"
+    conditions = [
+      {
+        type             = "first_seen_event"
+        comparison       = jsonencode(true)
+        condition_result = jsonencode(true)
+      },
+    ]
+  }
+
+  action_filters = [
+    {
+      logic_type = "all"
+      conditions = [
+        {
+          type             = "level"
+          comparison       = jsonencode({ level = "40", match = "gte" })
+          condition_result = jsonencode(true)
+        },
+      ]
+      actions = [
+        {
+          type   = "email"
+          data   = jsonencode({ fallthroughType = "ActiveMembers" })
+          config = jsonencode({ targetType = "issue_owners" })
+        },
+      ]
+    },
+  ]
+}
+
+# Move an existing issue alert to a workflow, keeping the migrated workflow
+moved {
+  from = sentry_issue_alert.main
+  to   = sentry_workflow.main
+}
@@ -0,0 +1,510 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var dataScrubbingMethods = []string{"mask", "remove", "hash", "replace"}
+
+var dataScrubbingTypes = []string{
+	"pattern",
+	"creditcard",
+	"password",
+	"ip",
+	"imei",
+	"email",
+	"uuid",
+	"pemkey",
+	"urlauth",
+	"usssn",
+	"userpath",
+	"mac",
+	"anything",
+}
+
+// DataScrubbingRuleModel is an advanced data scrubbing rule applied to a single
+// source. It is shared by the project and organization resources.
+type DataScrubbingRuleModel struct {
+	Method      types.String `tfsdk:"method"`
+	Type        types.String `tfsdk:"type"`
+	Source      types.String `tfsdk:"source"`
+	Pattern     types.String `tfsdk:"pattern"`
+	Replacement types.String `tfsdk:"replacement"`
+}
+
+func dataScrubbingRulesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The advanced data scrubbing rules, in order.",
+		Required:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"method": schema.StringAttribute{
+					MarkdownDescription: "How to scrub the matched data. One of `" + strings.Join(dataScrubbingMethods, "`, `") + "`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(dataScrubbingMethods...),
+					},
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The kind of data to scrub. One of `" + strings.Join(dataScrubbingTypes, "`, `") + "`. Set `pattern` to match a custom regular expression instead.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(dataScrubbingTypes...),
+					},
+				},
+				"source": schema.StringAttribute{
+					MarkdownDescription: "The selector of the event fields to scrub, e.g. `$string`, `$http.headers.x-custom-token` or `$frame.vars && !$frame.vars.safe`.",
+					Required:            true,
+					Validators: []validator.String{
+						dataScrubbingSelectorValidator{},
+					},
+				},
+				"pattern": schema.StringAttribute{
+					MarkdownDescription: "The regular expression to match. Required when `type` is `pattern`.",
+					Optional:            true,
+				},
+				"replacement": schema.StringAttribute{
+					MarkdownDescription: "The text that replaces the matched data. Required when `method` is `replace`.",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+// validateDataScrubbingRules checks the attributes that depend on the method
+// and type of each rule.
+func validateDataScrubbingRules(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var rulesList types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("rules"), &rulesList)...)
+	if diags.HasError() || rulesList.IsNull() || rulesList.IsUnknown() {
+		return diags
+	}
+
+	var rules []DataScrubbingRuleModel
+	diags.Append(rulesList.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, rule := range rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		if !rule.Type.IsUnknown() && !rule.Pattern.IsUnknown() {
+			if rule.Type.ValueString() == "pattern" && rule.Pattern.IsNull() {
+				diags.AddAttributeError(rulePath.AtName("pattern"), "Missing Attribute", "`pattern` is required when `type` is `pattern`.")
+			} else if rule.Type.ValueString() != "pattern" && !rule.Pattern.IsNull() {
+				diags.AddAttributeError(rulePath.AtName("pattern"), "Invalid Attribute", "`pattern` can only be set when `type` is `pattern`.")
+			}
+		}
+
+		if !rule.Method.IsUnknown() && !rule.Replacement.IsUnknown() {
+			if rule.Method.ValueString() == "replace" && rule.Replacement.IsNull() {
+				diags.AddAttributeError(rulePath.AtName("replacement"), "Missing Attribute", "`replacement` is required when `method` is `replace`.")
+			} else if rule.Method.ValueString() != "replace" && !rule.Replacement.IsNull() {
+				diags.AddAttributeError(rulePath.AtName("replacement"), "Invalid Attribute", "`replacement` can only be set when `method` is `replace`.")
+			}
+		}
+	}
+
+	return diags
+}
+
+// rawRelayPiiConfig keeps the rules of a `relayPiiConfig` JSON as they are, so
+// that the fields RelayPiiRule does not model survive a rewrite.
+type rawRelayPiiConfig struct {
+	Rules        map[string]json.RawMessage `json:"rules"`
+	Applications map[string][]string        `json:"applications"`
+}
+
+// buildRelayPiiConfig serializes the rules into the `relayPiiConfig` JSON. Each
+// rule is applied to its own source under the lowest free numeric identifier.
+// The unsupported rules of the current JSON, and the rules they refer to, are
+// carried over unchanged with their identifiers and sources. An empty string is
+// returned when there are no rules at all.
+func buildRelayPiiConfig(rules []DataScrubbingRuleModel, current string) (string, error) {
+	config, err := unsupportedRelayPiiRules(current)
+	if err != nil {
+		return "", err
+	}
+
+	next := 0
+	for _, rule := range rules {
+		for config.Rules[strconv.Itoa(next)] != nil {
+			next++
+		}
+		id := strconv.Itoa(next)
+		next++
+
+		b, err := json.Marshal(sentryclient.RelayPiiRule{
+			Type:    rule.Type.ValueString(),
+			Pattern: rule.Pattern.ValueStringPointer(),
+			Redaction: sentryclient.RelayPiiRedaction{
+				Method: rule.Method.ValueString(),
+				Text:   rule.Replacement.ValueStringPointer(),
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		config.Rules[id] = b
+		source := rule.Source.ValueString()
+		config.Applications[source] = append(config.Applications[source], id)
+	}
+
+	if len(config.Rules) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// unsupportedRelayPiiRules returns the rules of the `relayPiiConfig` JSON that
+// DataScrubbingRuleModel cannot represent, with the rules that `multiple` and
+// `alias` rules refer to, and the sources they are applied to.
+func unsupportedRelayPiiRules(s string) (rawRelayPiiConfig, error) {
+	result := rawRelayPiiConfig{
+		Rules:        map[string]json.RawMessage{},
+		Applications: map[string][]string{},
+	}
+	if strings.TrimSpace(s) == "" {
+		return result, nil
+	}
+
+	var config rawRelayPiiConfig
+	if err := json.Unmarshal([]byte(s), &config); err != nil {
+		return result, fmt.Errorf("unable to parse relayPiiConfig: %w", err)
+	}
+
+	var pending []string
+	for id, raw := range config.Rules {
+		var rule sentryclient.RelayPiiRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return result, fmt.Errorf("unable to parse relayPiiConfig rule %q: %w", id, err)
+		}
+		if !relayPiiRuleSupported(rule) {
+			pending = append(pending, id)
+		}
+	}
+	unsupported := slices.Clone(pending)
+
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		raw, ok := config.Rules[id]
+		if _, seen := result.Rules[id]; seen || !ok {
+			continue
+		}
+		result.Rules[id] = raw
+
+		var refs struct {
+			Rules []string `json:"rules"`
+			Rule  string   `json:"rule"`
+		}
+		if err := json.Unmarshal(raw, &refs); err != nil {
+			return result, fmt.Errorf("unable to parse relayPiiConfig rule %q: %w", id, err)
+		}
+		pending = append(pending, refs.Rules...)
+		if refs.Rule != "" {
+			pending = append(pending, refs.Rule)
+		}
+	}
+
+	for source, ids := range config.Applications {
+		for _, id := range ids {
+			if slices.Contains(unsupported, id) {
+				result.Applications[source] = append(result.Applications[source], id)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// relayPiiRuleSupported reports whether a rule can be represented by
+// DataScrubbingRuleModel. Rules that the Sentry UI or API create with other
+// types, e.g. `redact_pair`, `multiple` or `alias`, are not.
+func relayPiiRuleSupported(rule sentryclient.RelayPiiRule) bool {
+	return slices.Contains(dataScrubbingTypes, rule.Type) && slices.Contains(dataScrubbingMethods, rule.Redaction.Method)
+}
+
+// parseRelayPiiConfig reads the rules back from the `relayPiiConfig` JSON. The
+// result does not depend on the formatting of the JSON or the rule
+// identifiers, so configurations written by the Sentry UI do not cause drift as
+// long as they are equivalent. A rule applied to several sources is returned
+// once per source. Unsupported rules are skipped, see unsupportedRelayPiiRules.
+func parseRelayPiiConfig(s string) ([]DataScrubbingRuleModel, error) {
+	rules := []DataScrubbingRuleModel{}
+	if strings.TrimSpace(s) == "" {
+		return rules, nil
+	}
+
+	var config sentryclient.RelayPiiConfig
+	if err := json.Unmarshal([]byte(s), &config); err != nil {
+		return nil, fmt.Errorf("unable to parse relayPiiConfig: %w", err)
+	}
+
+	sources := map[string][]string{}
+	for source, ids := range config.Applications {
+		for _, id := range ids {
+			sources[id] = append(sources[id], source)
+		}
+	}
+
+	ids := make([]string, 0, len(config.Rules))
+	for id := range config.Rules {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, compareRuleIds)
+
+	for _, id := range ids {
+		rule := config.Rules[id]
+		if !relayPiiRuleSupported(rule) {
+			continue
+		}
+
+		ruleSources := sources[id]
+		slices.Sort(ruleSources)
+		for _, source := range ruleSources {
+			model := DataScrubbingRuleModel{
+				Method:      types.StringValue(rule.Redaction.Method),
+				Type:        types.StringValue(rule.Type),
+				Source:      types.StringValue(source),
+				Pattern:     types.StringNull(),
+				Replacement: types.StringNull(),
+			}
+			if rule.Type == "pattern" {
+				model.Pattern = types.StringPointerValue(rule.Pattern)
+			}
+			if rule.Redaction.Method == "replace" {
+				model.Replacement = types.StringPointerValue(rule.Redaction.Text)
+			}
+			rules = append(rules, model)
+		}
+	}
+
+	return rules, nil
+}
+
+// unsupportedRelayPiiRuleWarnings warns about each rule of the `relayPiiConfig`
+// JSON that parseRelayPiiConfig skips. Errors are left to parseRelayPiiConfig.
+func unsupportedRelayPiiRuleWarnings(s string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var config sentryclient.RelayPiiConfig
+	if strings.TrimSpace(s) == "" || json.Unmarshal([]byte(s), &config) != nil {
+		return diags
+	}
+
+	ids := make([]string, 0, len(config.Rules))
+	for id, rule := range config.Rules {
+		if !relayPiiRuleSupported(rule) {
+			ids = append(ids, id)
+		}
+	}
+	slices.SortFunc(ids, compareRuleIds)
+
+	for _, id := range ids {
+		rule := config.Rules[id]
+		diags.AddAttributeWarning(
+			path.Root("rules"),
+			"Unsupported Data Scrubbing Rule",
+			fmt.Sprintf("Rule %q of type %q with method %q cannot be managed by this resource. It is left unchanged when the rules are applied or removed.", id, rule.Type, rule.Redaction.Method),
+		)
+	}
+	return diags
+}
+
+// compareRuleIds orders numeric rule identifiers numerically, before any other
+// identifiers.
+func compareRuleIds(a, b string) int {
+	ai, aErr := strconv.Atoi(a)
+	bi, bErr := strconv.Atoi(b)
+	switch {
+	case aErr == nil && bErr == nil:
+		return ai - bi
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// parseDataScrubbingSelector checks the syntax of a Relay selector, e.g.
+// `$http.headers.'x-token' || ($frame.vars && !**.safe)`. Path items are
+// separated by dots and are one of `*`, `**`, a `$type`, a key, an index or a
+// quoted key. Selectors can be combined with `&&`, `||`, `!` and parentheses.
+func parseDataScrubbingSelector(s string) error {
+	p := &selectorParser{input: s}
+	if err := p.parseOr(); err != nil {
+		return err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return fmt.Errorf("unexpected %q at position %d", p.input[p.pos:], p.pos)
+	}
+	return nil
+}
+
+type selectorParser struct {
+	input string
+	pos   int
+}
+
+func (p *selectorParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *selectorParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *selectorParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.consume("||") {
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *selectorParser) parseAnd() error {
+	if err := p.parseNot(); err != nil {
+		return err
+	}
+	for p.consume("&&") {
+		if err := p.parseNot(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *selectorParser) parseNot() error {
+	if p.consume("!") {
+		return p.parseNot()
+	}
+	if p.consume("(") {
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		if !p.consume(")") {
+			return fmt.Errorf("missing closing parenthesis at position %d", p.pos)
+		}
+		return nil
+	}
+	return p.parsePath()
+}
+
+func (p *selectorParser) parsePath() error {
+	p.skipSpaces()
+	if err := p.parseItem(); err != nil {
+		return err
+	}
+	for p.pos < len(p.input) && p.input[p.pos] == '.' {
+		p.pos++
+		if err := p.parseItem(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *selectorParser) parseItem() error {
+	start := p.pos
+	rest := p.input[p.pos:]
+	switch {
+	case strings.HasPrefix(rest, "**"):
+		p.pos += 2
+		return nil
+	case strings.HasPrefix(rest, "*"):
+		p.pos++
+		return nil
+	case strings.HasPrefix(rest, "$"):
+		p.pos++
+		if p.consumeKey(func(c byte) bool { return isAlphanumeric(c) || c == '_' }) == 0 {
+			return fmt.Errorf("expected a type name after `$` at position %d", start)
+		}
+		return nil
+	case strings.HasPrefix(rest, "'"):
+		p.pos++
+		length := 0
+		for {
+			if p.pos >= len(p.input) {
+				return fmt.Errorf("unterminated quoted key at position %d", start)
+			}
+			if p.input[p.pos] == '\'' {
+				if strings.HasPrefix(p.input[p.pos:], "''") {
+					p.pos += 2
+					length++
+					continue
+				}
+				p.pos++
+				break
+			}
+			p.pos++
+			length++
+		}
+		if length == 0 {
+			return fmt.Errorf("empty quoted key at position %d", start)
+		}
+		return nil
+	default:
+		if p.consumeKey(func(c byte) bool { return isAlphanumeric(c) || c == '_' || c == '-' }) == 0 {
+			if p.pos >= len(p.input) {
+				return fmt.Errorf("expected a path item at the end of the selector")
+			}
+			return fmt.Errorf("unexpected %q at position %d", p.input[p.pos:p.pos+1], p.pos)
+		}
+		return nil
+	}
+}
+
+func (p *selectorParser) consumeKey(valid func(c byte) bool) int {
+	start := p.pos
+	for p.pos < len(p.input) && valid(p.input[p.pos]) {
+		p.pos++
+	}
+	return p.pos - start
+}
+
+func isAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRelayPiiConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        string
+		expected      []DataScrubbingRuleModel
+		expectedError bool
+	}{
+		"sentry ui": {
+			config: `{
+				"rules": {
+					"1": {"type": "pattern", "pattern": "secret-\\d+", "redaction": {"method": "replace", "text": "[secret]"}},
+					"0": {"type": "creditcard", "redaction": {"method": "mask"}}
+				},
+				"applications": {"$string": ["0"], "$http.headers.x-token || $message": ["1"], "$frame.vars": ["0"]}
+			}`,
+			expected: []DataScrubbingRuleModel{
+				{Method: types.StringValue("mask"), Type: types.StringValue("creditcard"), Source: types.StringValue("$frame.vars"), Pattern: types.StringNull(), Replacement: types.StringNull()},
+				{Method: types.StringValue("mask"), Type: types.StringValue("creditcard"), Source: types.StringValue("$string"), Pattern: types.StringNull(), Replacement: types.StringNull()},
+				{Method: types.StringValue("replace"), Type: types.StringValue("pattern"), Source: types.StringValue("$http.headers.x-token || $message"), Pattern: types.StringValue(`secret-\d+`), Replacement: types.StringValue("[secret]")},
+			},
+		},
+		"empty": {
+			config:   "",
+			expected: []DataScrubbingRuleModel{},
+		},
+		"unsupported types": {
+			config: `{
+				"rules": {
+					"0": {"type": "redact_pair", "keyPattern": "token", "redaction": {"method": "remove"}},
+					"1": {"type": "multiple", "rules": ["0"], "redaction": {"method": "remove"}},
+					"2": {"type": "alias", "rule": "0"},
+					"3": {"type": "email", "redaction": {"method": "hash"}}
+				},
+				"applications": {"$string": ["0", "1", "2", "3"]}
+			}`,
+			expected: []DataScrubbingRuleModel{
+				{Method: types.StringValue("hash"), Type: types.StringValue("email"), Source: types.StringValue("$string"), Pattern: types.StringNull(), Replacement: types.StringNull()},
+			},
+		},
+		"invalid json": {
+			config:        `{"rules":`,
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := parseRelayPiiConfig(tc.config)
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, rules); diff != "" {
+				t.Errorf("unexpected rules (-want +got):\n%s", diff)
+			}
+
+			// The rules survive a round trip unchanged.
+			config, err := buildRelayPiiConfig(rules, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			roundTripped, err := parseRelayPiiConfig(config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(rules, roundTripped); diff != "" {
+				t.Errorf("unexpected round trip (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildRelayPiiConfigKeepsUnsupportedRules(t *testing.T) {
+	t.Parallel()
+
+	current := `{
+		"rules": {
+			"0": {"type": "redact_pair", "keyPattern": "token", "redaction": {"method": "remove"}},
+			"1": {"type": "email", "redaction": {"method": "hash"}},
+			"2": {"type": "multiple", "rules": ["1"], "hide_inner": true, "redaction": {"method": "remove"}},
+			"3": {"type": "ip", "redaction": {"method": "mask"}}
+		},
+		"applications": {"$string": ["0", "1", "3"], "$http.headers": ["2"]}
+	}`
+	rules := []DataScrubbingRuleModel{
+		{Method: types.StringValue("mask"), Type: types.StringValue("creditcard"), Source: types.StringValue("$string"), Pattern: types.StringNull(), Replacement: types.StringNull()},
+	}
+
+	config, err := buildRelayPiiConfig(rules, current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got rawRelayPiiConfig
+	if err := json.Unmarshal([]byte(config), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string][]string{"$string": {"0", "3"}, "$http.headers": {"2"}}
+	if diff := cmp.Diff(expected, got.Applications); diff != "" {
+		t.Errorf("unexpected applications (-want +got):\n%s", diff)
+	}
+	if len(got.Rules) != 4 {
+		t.Fatalf("expected 4 rules, got %d", len(got.Rules))
+	}
+	var want rawRelayPiiConfig
+	if err := json.Unmarshal([]byte(current), &want); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, id := range []string{"0", "1", "2"} {
+		var wantRule, gotRule map[string]interface{}
+		if err := json.Unmarshal(want.Rules[id], &wantRule); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := json.Unmarshal(got.Rules[id], &gotRule); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(wantRule, gotRule); diff != "" {
+			t.Errorf("expected rule %q to be kept unchanged (-want +got):\n%s", id, diff)
+		}
+	}
+
+	parsed, err := parseRelayPiiConfig(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(rules, parsed); diff != "" {
+		t.Errorf("unexpected rules (-want +got):\n%s", diff)
+	}
+
+	// Removing the rules keeps the unsupported ones.
+	config, err = buildRelayPiiConfig(nil, current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got = rawRelayPiiConfig{}
+	if err := json.Unmarshal([]byte(config), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Rules) != 3 {
+		t.Errorf("expected 3 rules, got %d", len(got.Rules))
+	}
+
+	if config, err := buildRelayPiiConfig(nil, ""); err != nil || config != "" {
+		t.Errorf("expected an empty config, got %q, %v", config, err)
+	}
+}
+
+func TestUnsupportedRelayPiiRuleWarnings(t *testing.T) {
+	t.Parallel()
+
+	diags := unsupportedRelayPiiRuleWarnings(`{
+		"rules": {
+			"10": {"type": "alias", "rule": "0"},
+			"0": {"type": "redact_pair", "keyPattern": "token", "redaction": {"method": "remove"}},
+			"1": {"type": "email", "redaction": {"method": "hash"}}
+		},
+		"applications": {"$string": ["0", "1", "10"]}
+	}`)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 2 {
+		t.Fatalf("expected 2 warnings, got %d", diags.WarningsCount())
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, `"redact_pair"`) {
+		t.Errorf("expected the first warning to be about rule 0, got %q", detail)
+	}
+
+	if diags := unsupportedRelayPiiRuleWarnings(`{"rules":`); len(diags) != 0 {
+		t.Errorf("expected no diagnostics for invalid JSON, got %v", diags)
+	}
+}
+
+func TestParseDataScrubbingSelector(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"$string":                           true,
+		"**":                                true,
+		"$http.headers.x-custom-token":      true,
+		"$frame.vars.'my key'":              true,
+		"$frame.vars.'it''s'":               true,
+		"extra.0.password":                  true,
+		"$error.value && !$string":          true,
+		"($message || $logentry) && !**.id": true,
+		"":                                  false,
+		"$":                                 false,
+		"$http.":                            false,
+		"$http..headers":                    false,
+		"$string &&":                        false,
+		"($string":                          false,
+		"$frame.vars.''":                    false,
+		"$frame.vars.'unterminated":         false,
+		"$string $message":                  false,
+		"headers/authorization":             false,
+	}
+
+	for selector, valid := range testCases {
+		selector, valid := selector, valid
+		t.Run(selector, func(t *testing.T) {
+			t.Parallel()
+
+			err := parseDataScrubbingSelector(selector)
+			if valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !valid && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -128,6 +128,32 @@ func (d *AllClientKeysDataSource) Schema(ctx context.Context, req datasource.Sch
 							MarkdownDescription: "Security header endpoint for features like CSP and Expect-CT reports.",
 							Computed:            true,
 						},
+						"javascript_loader_script": schema.SingleNestedAttribute{
+							MarkdownDescription: "The settings of the JavaScript Loader Script of the key.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"browser_sdk_version": schema.StringAttribute{
+									MarkdownDescription: "The version of the browser SDK to load.",
+									Computed:            true,
+								},
+								"performance_monitoring_enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether the loaded SDK has performance monitoring enabled.",
+									Computed:            true,
+								},
+								"session_replay_enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether the loaded SDK has session replay enabled.",
+									Computed:            true,
+								},
+								"debug_enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether the loaded SDK is the debug bundle.",
+									Computed:            true,
+								},
+							},
+						},
+						"javascript_loader_script_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the JavaScript Loader Script of the key.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -26,18 +26,20 @@ func TestAccAllClientKeysDataSource(t *testing.T) {
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("keys"), knownvalue.ListExact([]knownvalue.Check{
 						knownvalue.MapExact(map[string]knownvalue.Check{
-							"id":                knownvalue.NotNull(),
-							"organization":      knownvalue.StringExact(acctest.TestOrganization),
-							"project":           knownvalue.StringExact(project),
-							"project_id":        knownvalue.NotNull(),
-							"name":              knownvalue.StringExact("Default"),
-							"public":            knownvalue.NotNull(),
-							"secret":            knownvalue.NotNull(),
-							"rate_limit_window": knownvalue.Null(),
-							"rate_limit_count":  knownvalue.Null(),
-							"dsn_public":        knownvalue.NotNull(),
-							"dsn_secret":        knownvalue.NotNull(),
-							"dsn_csp":           knownvalue.NotNull(),
+							"id":                           knownvalue.NotNull(),
+							"organization":                 knownvalue.StringExact(acctest.TestOrganization),
+							"project":                      knownvalue.StringExact(project),
+							"project_id":                   knownvalue.NotNull(),
+							"name":                         knownvalue.StringExact("Default"),
+							"public":                       knownvalue.NotNull(),
+							"secret":                       knownvalue.NotNull(),
+							"rate_limit_window":            knownvalue.Null(),
+							"rate_limit_count":             knownvalue.Null(),
+							"dsn_public":                   knownvalue.NotNull(),
+							"dsn_secret":                   knownvalue.NotNull(),
+							"dsn_csp":                      knownvalue.NotNull(),
+							"javascript_loader_script":     knownvalue.NotNull(),
+							"javascript_loader_script_url": knownvalue.NotNull(),
 						}),
 					})),
 				},
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ datasource.DataSource = &AllExternalIdentitiesDataSource{}
+var _ datasource.DataSourceWithConfigure = &AllExternalIdentitiesDataSource{}
+
+func NewAllExternalIdentitiesDataSource() datasource.DataSource {
+	return &AllExternalIdentitiesDataSource{}
+}
+
+type AllExternalIdentitiesDataSource struct {
+	baseDataSource
+}
+
+type AllExternalIdentitiesDataSourceModel struct {
+	Organization      types.String                `tfsdk:"organization"`
+	Provider          types.String                `tfsdk:"provider_key"`
+	Users             []ExternalUserResourceModel `tfsdk:"users"`
+	Teams             []ExternalTeamResourceModel `tfsdk:"teams"`
+	UnmappedMemberIds types.Set                   `tfsdk:"unmapped_member_ids"`
+	UnmappedTeams     types.Set                   `tfsdk:"unmapped_teams"`
+}
+
+func (m *AllExternalIdentitiesDataSourceModel) Fill(organization string, provider *string, externalUsers map[string][]sentryclient.ExternalActor, externalTeams map[string][]sentryclient.ExternalActor) error {
+	m.Organization = types.StringValue(organization)
+	m.Provider = types.StringPointerValue(provider)
+
+	m.Users = []ExternalUserResourceModel{}
+	unmappedMemberIds := []string{}
+	for memberId, actors := range externalUsers {
+		mapped := false
+		for _, actor := range actors {
+			if provider != nil && actor.Provider != *provider {
+				continue
+			}
+			var model ExternalUserResourceModel
+			if err := model.Fill(organization, memberId, actor); err != nil {
+				return err
+			}
+			m.Users = append(m.Users, model)
+			mapped = true
+		}
+		if !mapped {
+			unmappedMemberIds = append(unmappedMemberIds, memberId)
+		}
+	}
+
+	m.Teams = []ExternalTeamResourceModel{}
+	unmappedTeams := []string{}
+	for team, actors := range externalTeams {
+		mapped := false
+		for _, actor := range actors {
+			if provider != nil && actor.Provider != *provider {
+				continue
+			}
+			var model ExternalTeamResourceModel
+			if err := model.Fill(organization, team, actor); err != nil {
+				return err
+			}
+			m.Teams = append(m.Teams, model)
+			mapped = true
+		}
+		if !mapped {
+			unmappedTeams = append(unmappedTeams, team)
+		}
+	}
+
+	// Keep the order stable across reads.
+	slices.SortFunc(m.Users, func(a, b ExternalUserResourceModel) int {
+		return strings.Compare(a.Id.ValueString(), b.Id.ValueString())
+	})
+	slices.SortFunc(m.Teams, func(a, b ExternalTeamResourceModel) int {
+		return strings.Compare(a.Id.ValueString(), b.Id.ValueString())
+	})
+
+	m.UnmappedMemberIds = types.SetValueMust(types.StringType, stringsToValues(unmappedMemberIds))
+	m.UnmappedTeams = types.SetValueMust(types.StringType, stringsToValues(unmappedTeams))
+
+	return nil
+}
+
+func (d *AllExternalIdentitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_all_external_identities"
+}
+
+func (d *AllExternalIdentitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	externalActorAttributes := func(kind string, parent string, parentDescription string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the external " + kind + ".",
+				Computed:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Computed:            true,
+			},
+			parent: schema.StringAttribute{
+				MarkdownDescription: parentDescription,
+				Computed:            true,
+			},
+			"provider_key": schema.StringAttribute{
+				MarkdownDescription: "The provider of the external " + kind + ".",
+				Computed:            true,
+			},
+			"external_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the " + kind + " in the provider.",
+				Computed:            true,
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the " + kind + " in the provider.",
+				Computed:            true,
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization integration of the provider.",
+				Computed:            true,
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the external users and teams of an organization, i.e. the users and teams of providers such as GitHub or Slack that are linked to Sentry members and teams, along with the members and teams that are not linked yet.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"provider_key": schema.StringAttribute{
+				MarkdownDescription: "Only list the external users and teams of this provider. One of `" + strings.Join(externalActorProviders, "`, `") + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(externalActorProviders...),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The external users.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: externalActorAttributes("user", "member_id", "The ID of the organization member."),
+				},
+			},
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "The external teams.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: externalActorAttributes("team", "team", "The slug of the team."),
+				},
+			},
+			"unmapped_member_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the members that are not linked to any external user of the provider. Pending invites are not included.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"unmapped_teams": schema.SetAttribute{
+				MarkdownDescription: "The slugs of the teams that are not linked to any external team of the provider.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *AllExternalIdentitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AllExternalIdentitiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := data.Organization.ValueString()
+
+	externalUsers := map[string][]sentryclient.ExternalActor{}
+	membersParams := &sentry.ListCursorParams{}
+	for {
+		members, apiResp, err := d.client.OrganizationMembers.List(ctx, organization, membersParams)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Read error: %s", err))
+			return
+		}
+
+		for _, member := range members {
+			if member.Pending {
+				continue
+			}
+			actors, _, err := sentryclient.ListMemberExternalUsers(ctx, d.client, organization, member.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Read error: %s", err))
+				return
+			}
+			externalUsers[member.ID] = actors
+		}
+
+		if apiResp.Cursor == "" {
+			break
+		}
+		membersParams.Cursor = apiResp.Cursor
+	}
+
+	externalTeams := map[string][]sentryclient.ExternalActor{}
+	teamsParams := &sentry.ListCursorParams{}
+	for {
+		teams, apiResp, err := d.client.Teams.List(ctx, organization, teamsParams)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Read error: %s", err))
+			return
+		}
+
+		for _, team := range teams {
+			slug := sentry.StringValue(team.Slug)
+			actors, _, err := sentryclient.ListTeamExternalTeams(ctx, d.client, organization, slug)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Read error: %s", err))
+				return
+			}
+			externalTeams[slug] = actors
+		}
+
+		if apiResp.Cursor == "" {
+			break
+		}
+		teamsParams.Cursor = apiResp.Cursor
+	}
+
+	if err := data.Fill(organization, data.Provider.ValueStringPointer(), externalUsers, externalTeams); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
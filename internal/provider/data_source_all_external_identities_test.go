@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestAllExternalIdentitiesDataSourceModelFill(t *testing.T) {
+	t.Parallel()
+
+	externalUsers := map[string][]sentryclient.ExternalActor{
+		"1": {
+			{ID: "10", Provider: "github", ExternalName: "@jane", IntegrationID: "100"},
+			{ID: "11", Provider: "slack", ExternalName: "@jane", ExternalID: sentry.String("U123"), IntegrationID: "200"},
+		},
+		"2": {
+			{ID: "12", Provider: "slack", ExternalName: "@john", IntegrationID: "200"},
+		},
+		"3": {},
+	}
+	externalTeams := map[string][]sentryclient.ExternalActor{
+		"payments": {
+			{ID: "20", Provider: "github", ExternalName: "@acme/payments", IntegrationID: "100"},
+		},
+		"checkout": {},
+	}
+
+	testCases := map[string]struct {
+		provider                  *string
+		expectedUserIds           []string
+		expectedTeamIds           []string
+		expectedUnmappedMembers   []string
+		expectedUnmappedTeamSlugs []string
+	}{
+		"all providers": {
+			expectedUserIds:           []string{"10", "11", "12"},
+			expectedTeamIds:           []string{"20"},
+			expectedUnmappedMembers:   []string{"3"},
+			expectedUnmappedTeamSlugs: []string{"checkout"},
+		},
+		"github": {
+			provider:                  sentry.String("github"),
+			expectedUserIds:           []string{"10"},
+			expectedTeamIds:           []string{"20"},
+			expectedUnmappedMembers:   []string{"2", "3"},
+			expectedUnmappedTeamSlugs: []string{"checkout"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var data AllExternalIdentitiesDataSourceModel
+			if err := data.Fill("my-org", tc.provider, externalUsers, externalTeams); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			userIds := []string{}
+			for _, user := range data.Users {
+				userIds = append(userIds, user.Id.ValueString())
+			}
+			teamIds := []string{}
+			for _, team := range data.Teams {
+				teamIds = append(teamIds, team.Id.ValueString())
+			}
+			if diff := cmp.Diff(tc.expectedUserIds, userIds); diff != "" {
+				t.Errorf("unexpected users (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedTeamIds, teamIds); diff != "" {
+				t.Errorf("unexpected teams (-want +got):\n%s", diff)
+			}
+
+			expectedUnmappedMembers := types.SetValueMust(types.StringType, stringsToValues(tc.expectedUnmappedMembers))
+			if !data.UnmappedMemberIds.Equal(expectedUnmappedMembers) {
+				t.Errorf("expected unmapped members %v, got %v", expectedUnmappedMembers, data.UnmappedMemberIds)
+			}
+			expectedUnmappedTeams := types.SetValueMust(types.StringType, stringsToValues(tc.expectedUnmappedTeamSlugs))
+			if !data.UnmappedTeams.Equal(expectedUnmappedTeams) {
+				t.Errorf("expected unmapped teams %v, got %v", expectedUnmappedTeams, data.UnmappedTeams)
+			}
+		})
+	}
+}
+
+func TestAccAllExternalIdentitiesDataSource(t *testing.T) {
+	rn := "data.sentry_all_external_identities.test"
+	team := acctest.RandomWithPrefix("tf-team")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceConfig(team) + `
+data "sentry_all_external_identities" "test" {
+	organization = sentry_team.test.organization
+	provider_key = "github"
+
+	depends_on = [sentry_team.test]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("provider_key"), knownvalue.StringExact("github")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("users"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("unmapped_teams"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.StringExact(team),
+					})),
+				},
+			},
+		},
+	})
+}
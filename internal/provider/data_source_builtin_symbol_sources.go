@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ datasource.DataSource = &BuiltinSymbolSourcesDataSource{}
+var _ datasource.DataSourceWithConfigure = &BuiltinSymbolSourcesDataSource{}
+
+func NewBuiltinSymbolSourcesDataSource() datasource.DataSource {
+	return &BuiltinSymbolSourcesDataSource{}
+}
+
+type BuiltinSymbolSourcesDataSource struct {
+	baseDataSource
+}
+
+type BuiltinSymbolSourceModel struct {
+	Key    types.String `tfsdk:"key"`
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Hidden types.Bool   `tfsdk:"hidden"`
+}
+
+type BuiltinSymbolSourcesDataSourceModel struct {
+	Organization types.String               `tfsdk:"organization"`
+	Sources      []BuiltinSymbolSourceModel `tfsdk:"sources"`
+}
+
+func (m *BuiltinSymbolSourcesDataSourceModel) Fill(organization string, sources []*sentryclient.BuiltinSymbolSource) error {
+	m.Organization = types.StringValue(organization)
+
+	m.Sources = []BuiltinSymbolSourceModel{}
+	for _, source := range sources {
+		m.Sources = append(m.Sources, BuiltinSymbolSourceModel{
+			Key:    types.StringValue(source.Key),
+			Id:     types.StringValue(source.ID),
+			Name:   types.StringValue(source.Name),
+			Hidden: types.BoolValue(source.Hidden),
+		})
+	}
+
+	return nil
+}
+
+func (d *BuiltinSymbolSourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_builtin_symbol_sources"
+}
+
+func (d *BuiltinSymbolSourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the built-in symbol sources that projects of an organization can enable with `sentry_project_builtin_symbol_sources`.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"sources": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of built-in symbol sources.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key that enables the source, e.g. `microsoft`.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The internal ID of the source, e.g. `sentry:microsoft`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The human-readable name of the source.",
+							Computed:            true,
+						},
+						"hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the source is hidden from the project settings in Sentry.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BuiltinSymbolSourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BuiltinSymbolSourcesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, _, err := sentryclient.ListBuiltinSymbolSources(ctx, d.client, data.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Read error: %s", err))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), sources); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -40,6 +40,9 @@ type ClientKeyDataSourceModel struct {
 	DsnPublic       types.String `tfsdk:"dsn_public"`
 	DsnSecret       types.String `tfsdk:"dsn_secret"`
 	DsnCsp          types.String `tfsdk:"dsn_csp"`
+
+	JavascriptLoaderScript    types.Object `tfsdk:"javascript_loader_script"`
+	JavascriptLoaderScriptUrl types.String `tfsdk:"javascript_loader_script_url"`
 }
 
 func (m *ClientKeyDataSourceModel) Fill(organization string, project string, first bool, key sentry.ProjectKey) error {
@@ -64,6 +67,9 @@ func (m *ClientKeyDataSourceModel) Fill(organization string, project string, fir
 	m.DsnSecret = types.StringValue(key.DSN.Secret)
 	m.DsnCsp = types.StringValue(key.DSN.CSP)
 
+	m.JavascriptLoaderScript = clientKeyJavascriptLoaderScriptValue(key)
+	m.JavascriptLoaderScriptUrl = types.StringValue(key.DSN.CDN)
+
 	return nil
 }
 
@@ -128,6 +134,32 @@ func (d *ClientKeyDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "Security header endpoint for features like CSP and Expect-CT reports.",
 				Computed:            true,
 			},
+			"javascript_loader_script": schema.SingleNestedAttribute{
+				MarkdownDescription: "The settings of the JavaScript Loader Script of the key.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"browser_sdk_version": schema.StringAttribute{
+						MarkdownDescription: "The version of the browser SDK to load.",
+						Computed:            true,
+					},
+					"performance_monitoring_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the loaded SDK has performance monitoring enabled.",
+						Computed:            true,
+					},
+					"session_replay_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the loaded SDK has session replay enabled.",
+						Computed:            true,
+					},
+					"debug_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the loaded SDK is the debug bundle.",
+						Computed:            true,
+					},
+				},
+			},
+			"javascript_loader_script_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the JavaScript Loader Script of the key.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -30,6 +30,8 @@ func TestAccClientKeyDataSource_MigrateFromPluginSDK(t *testing.T) {
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_public"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_secret"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_csp"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script").AtMapKey("browser_sdk_version"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script_url"), knownvalue.NotNull()),
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -74,6 +76,8 @@ func TestAccClientKeyDataSource_id(t *testing.T) {
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_public"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_secret"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_csp"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script").AtMapKey("browser_sdk_version"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script_url"), knownvalue.NotNull()),
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -110,6 +114,8 @@ func TestAccClientKeyDataSource_name(t *testing.T) {
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_public"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_secret"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_csp"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script").AtMapKey("browser_sdk_version"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script_url"), knownvalue.NotNull()),
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -146,6 +152,8 @@ func TestAccClientKeyDataSource_first(t *testing.T) {
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_public"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_secret"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_csp"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script").AtMapKey("browser_sdk_version"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script_url"), knownvalue.NotNull()),
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ datasource.DataSource = &ProjectEnvironmentsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ProjectEnvironmentsDataSource{}
+
+func NewProjectEnvironmentsDataSource() datasource.DataSource {
+	return &ProjectEnvironmentsDataSource{}
+}
+
+type ProjectEnvironmentsDataSource struct {
+	baseDataSource
+}
+
+type ProjectEnvironmentsDataSourceModel struct {
+	Organization types.String                      `tfsdk:"organization"`
+	Project      types.String                      `tfsdk:"project"`
+	Visibility   types.String                      `tfsdk:"visibility"`
+	Environments []ProjectEnvironmentResourceModel `tfsdk:"environments"`
+}
+
+func (m *ProjectEnvironmentsDataSourceModel) Fill(organization string, project string, visibility *string, environments []*sentryclient.ProjectEnvironment) error {
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Visibility = types.StringPointerValue(visibility)
+
+	m.Environments = []ProjectEnvironmentResourceModel{}
+	for _, environment := range environments {
+		var model ProjectEnvironmentResourceModel
+		if err := model.Fill(organization, project, *environment); err != nil {
+			return err
+		}
+
+		m.Environments = append(m.Environments, model)
+	}
+
+	return nil
+}
+
+func (d *ProjectEnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environments"
+}
+
+func (d *ProjectEnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List a Project's Environments.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Filter environments by `visible` or `hidden`. Defaults to returning all environments if not specified.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"all",
+						"visible",
+						"hidden",
+					),
+				},
+			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of environments.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of this resource.",
+							Computed:            true,
+						},
+						"organization": schema.StringAttribute{
+							MarkdownDescription: "The slug of the organization the project belongs to.",
+							Computed:            true,
+						},
+						"project": schema.StringAttribute{
+							MarkdownDescription: "The slug of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment.",
+							Computed:            true,
+						},
+						"is_hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the environment is hidden.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectEnvironmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	visibility := "all"
+	if !data.Visibility.IsNull() {
+		visibility = data.Visibility.ValueString()
+	}
+
+	environments, _, err := sentryclient.ListProjectEnvironments(ctx, d.client, data.Organization.ValueString(), data.Project.ValueString(), visibility)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Read error: %s", err))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), data.Visibility.ValueStringPointer(), environments); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
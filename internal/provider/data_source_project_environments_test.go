@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestProjectEnvironmentsDataSourceModelFill(t *testing.T) {
+	t.Parallel()
+
+	var data ProjectEnvironmentsDataSourceModel
+	if err := data.Fill("my-org", "my-project", nil, []*sentryclient.ProjectEnvironment{
+		{ID: "1", Name: "production", IsHidden: false},
+		{ID: "2", Name: "staging", IsHidden: true},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type environment struct {
+		Id       string
+		Name     string
+		IsHidden bool
+	}
+	got := []environment{}
+	for _, e := range data.Environments {
+		got = append(got, environment{e.Id.ValueString(), e.Name.ValueString(), e.IsHidden.ValueBool()})
+	}
+	expected := []environment{
+		{"my-org/my-project/production", "production", false},
+		{"my-org/my-project/staging", "staging", true},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected environments (-want +got):\n%s", diff)
+	}
+}
+
+func TestAccProjectEnvironmentsDataSource(t *testing.T) {
+	dn := "data.sentry_project_environments.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+data "sentry_project_environments" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	visibility   = "visible"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("visibility"), knownvalue.StringExact("visible")),
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("environments"), knownvalue.ListExact([]knownvalue.Check{})),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ datasource.DataSource = &ReleaseThresholdsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ReleaseThresholdsDataSource{}
+
+func NewReleaseThresholdsDataSource() datasource.DataSource {
+	return &ReleaseThresholdsDataSource{}
+}
+
+type ReleaseThresholdsDataSource struct {
+	baseDataSource
+}
+
+type ReleaseThresholdsDataSourceModel struct {
+	Organization types.String                    `tfsdk:"organization"`
+	Project      types.String                    `tfsdk:"project"`
+	Thresholds   []ReleaseThresholdResourceModel `tfsdk:"thresholds"`
+}
+
+func (m *ReleaseThresholdsDataSourceModel) Fill(organization string, project string, thresholds []*sentryclient.ReleaseThreshold) error {
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+
+	m.Thresholds = []ReleaseThresholdResourceModel{}
+	for _, threshold := range thresholds {
+		var model ReleaseThresholdResourceModel
+		if err := model.Fill(organization, project, *threshold); err != nil {
+			return err
+		}
+
+		m.Thresholds = append(m.Thresholds, model)
+	}
+
+	return nil
+}
+
+func (d *ReleaseThresholdsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release_thresholds"
+}
+
+func (d *ReleaseThresholdsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List a Project's Release Thresholds.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+			},
+			"thresholds": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of release thresholds.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the release threshold.",
+							Computed:            true,
+						},
+						"organization": schema.StringAttribute{
+							MarkdownDescription: "The slug of the organization the project belongs to.",
+							Computed:            true,
+						},
+						"project": schema.StringAttribute{
+							MarkdownDescription: "The slug of the project.",
+							Computed:            true,
+						},
+						"threshold_type": schema.StringAttribute{
+							MarkdownDescription: "The metric that is checked.",
+							Computed:            true,
+						},
+						"trigger_type": schema.StringAttribute{
+							MarkdownDescription: "Whether the threshold is breached when the metric goes `over` or `under` the value.",
+							Computed:            true,
+						},
+						"value": schema.Int64Attribute{
+							MarkdownDescription: "The value of the threshold.",
+							Computed:            true,
+						},
+						"window_in_seconds": schema.Int64Attribute{
+							MarkdownDescription: "How long after the release is deployed the threshold is checked, in seconds.",
+							Computed:            true,
+						},
+						"environment": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment the threshold applies to, or null for all environments.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ReleaseThresholdsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReleaseThresholdsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	thresholds, _, err := sentryclient.ListReleaseThresholds(ctx, d.client, data.Organization.ValueString(), data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Read error: %s", err))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), thresholds); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccReleaseThresholdsDataSource(t *testing.T) {
+	dn := "data.sentry_release_thresholds.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReleaseThresholdResourceConfig(team, project, "over", 100, "") + `
+data "sentry_release_thresholds" "test" {
+	organization = sentry_release_threshold.test.organization
+	project      = sentry_release_threshold.test.project
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("thresholds"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"threshold_type":    knownvalue.StringExact("total_error_count"),
+							"trigger_type":      knownvalue.StringExact("over"),
+							"value":             knownvalue.Int64Exact(100),
+							"window_in_seconds": knownvalue.Int64Exact(3600),
+							"environment":       knownvalue.Null(),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -33,3 +33,14 @@ func splitSentryAlertID(id string) (org string, project string, alertID string,
 	org, project, alertID, err = splitThreePartID(id, "organization-slug", "project-slug", "alert-id")
 	return
 }
+
+// splitSentryAlertImportID splits an alert import ID of the form
+// `organization/project/alert-id` or `organization/project/name:<rule name>`.
+// Rule names may contain slashes.
+func splitSentryAlertImportID(id string) (org string, project string, alertRef string, err error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected organization/project-slug/alert-id or organization/project-slug/name:<rule name>", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -95,28 +95,53 @@ func (p *SentryProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 func (p *SentryProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAlertPolicyResource,
+		NewAlertSnoozeResource,
 		NewAllProjectsSpikeProtectionResource,
 		NewClientKeyResource,
+		NewClientKeyRotationResource,
+		NewDetectorResource,
+		NewExternalTeamResource,
+		NewExternalUserResource,
 		NewIntegrationOpsgenie,
 		NewIntegrationPagerDuty,
 		NewIssueAlertResource,
 		NewNotificationActionResource,
+		NewOrganizationDataScrubbingResource,
+		NewProjectResource,
+		NewProjectBuiltinSymbolSourcesResource,
+		NewProjectCodeOwnersResource,
+		NewProjectDataForwardingResource,
+		NewProjectDataScrubbingResource,
+		NewProjectEnvironmentResource,
+		NewProjectInboundCustomFilterResource,
 		NewProjectInboundDataFilterResource,
+		NewProjectOwnershipResource,
+		NewProjectPerformanceIssueSettingsResource,
+		NewProjectSamplingResource,
+		NewProjectServiceHookResource,
 		NewProjectSpikeProtectionResource,
 		NewProjectSymbolSourcesResource,
+		NewProjectTeamResource,
+		NewReleaseThresholdResource,
 		NewTeamMemberResource,
+		NewWorkflowResource,
 	}
 }
 
 func (p *SentryProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAllClientKeysDataSource,
+		NewAllExternalIdentitiesDataSource,
 		NewAllProjectsDataSource,
+		NewBuiltinSymbolSourcesDataSource,
 		NewClientKeyDataSource,
 		NewIssueAlertDataSource,
 		NewOrganizationIntegrationDataSource,
 		NewOrganizationMemberDataSource,
 		NewProjectDataSource,
+		NewProjectEnvironmentsDataSource,
+		NewReleaseThresholdsDataSource,
 	}
 }
 
@@ -0,0 +1,668 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	stdpath "path"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+	"github.com/canva/terraform-provider-sentry/internal/sentrytypes"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+var _ resource.Resource = &AlertPolicyResource{}
+var _ resource.ResourceWithConfigure = &AlertPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &AlertPolicyResource{}
+
+// privateKeyDriftedAlertRules holds the projects whose rule no longer matches
+// the template, e.g. because it was edited in the Sentry UI. They are rewritten
+// by the next update.
+const privateKeyDriftedAlertRules = "drifted_alert_rules"
+
+func driftedAlertRulesFromPrivate(ctx context.Context, private privateStateGetter) ([]string, diag.Diagnostics) {
+	var projects []string
+
+	value, diags := private.GetKey(ctx, privateKeyDriftedAlertRules)
+	if diags.HasError() || len(value) == 0 {
+		return projects, diags
+	}
+
+	if err := json.Unmarshal(value, &projects); err != nil {
+		diags.AddError("Private State Error", fmt.Sprintf("Error reading the drifted alert rules: %s", err.Error()))
+	}
+	return projects, diags
+}
+
+func NewAlertPolicyResource() resource.Resource {
+	return &AlertPolicyResource{}
+}
+
+type AlertPolicyResource struct {
+	baseResource
+}
+
+type AlertPolicySelectorModel struct {
+	Teams       types.Set    `tfsdk:"teams"`
+	Platforms   types.Set    `tfsdk:"platforms"`
+	SlugPattern types.String `tfsdk:"slug_pattern"`
+}
+
+type AlertPolicyTemplateModel struct {
+	Conditions  sentrytypes.LossyJson `tfsdk:"conditions"`
+	Filters     sentrytypes.LossyJson `tfsdk:"filters"`
+	Actions     sentrytypes.LossyJson `tfsdk:"actions"`
+	ActionMatch types.String          `tfsdk:"action_match"`
+	FilterMatch types.String          `tfsdk:"filter_match"`
+	Frequency   types.Int64           `tfsdk:"frequency"`
+	Environment types.String          `tfsdk:"environment"`
+	Owner       types.String          `tfsdk:"owner"`
+}
+
+type AlertPolicyResourceModel struct {
+	Id           types.String              `tfsdk:"id"`
+	Organization types.String              `tfsdk:"organization"`
+	Name         types.String              `tfsdk:"name"`
+	Selector     *AlertPolicySelectorModel `tfsdk:"selector"`
+	Template     *AlertPolicyTemplateModel `tfsdk:"template"`
+	Rules        types.Map                 `tfsdk:"rules"`
+}
+
+func (m *AlertPolicyResourceModel) Fill(organization string, rules map[string]string) error {
+	m.Id = types.StringValue(buildTwoPartID(organization, m.Name.ValueString()))
+	m.Organization = types.StringValue(organization)
+
+	ruleElements := map[string]attr.Value{}
+	for project, ruleId := range rules {
+		ruleElements[project] = types.StringValue(ruleId)
+	}
+	m.Rules = types.MapValueMust(types.StringType, ruleElements)
+
+	return nil
+}
+
+// projectSelector matches projects against the criteria of an alert policy.
+// All criteria that are set must match.
+type projectSelector struct {
+	teams       []string
+	platforms   []string
+	slugPattern string
+}
+
+func (s projectSelector) Matches(project sentry.Project) bool {
+	if len(s.teams) > 0 {
+		matched := false
+		for _, team := range project.Teams {
+			if slices.Contains(s.teams, sentry.StringValue(team.Slug)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(s.platforms) > 0 && !slices.Contains(s.platforms, project.Platform) {
+		return false
+	}
+
+	if s.slugPattern != "" {
+		if matched, err := stdpath.Match(s.slugPattern, project.Slug); err != nil || !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *AlertPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_policy"
+}
+
+func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Alert Policy resource. Maintains a copy of an issue alert on every project in an organization that matches a selector. Rules are created and deleted as projects start or stop matching the selector. Rules edited outside of Terraform are rewritten to match the template on the next apply. Only rules created by this resource are managed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the resource belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the issue alert created on each project.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"selector": schema.SingleNestedAttribute{
+				MarkdownDescription: "The projects the issue alert is created on. A project is selected when it matches all of the specified criteria.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"teams": schema.SetAttribute{
+						MarkdownDescription: "Select projects owned by any of these team slugs.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"platforms": schema.SetAttribute{
+						MarkdownDescription: "Select projects with any of these platforms.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"slug_pattern": schema.StringAttribute{
+						MarkdownDescription: "Select projects whose slug matches this glob pattern, e.g. `backend-*`.",
+						Optional:            true,
+						Validators: []validator.String{
+							globValidator{},
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AtLeastOneOf(
+						path.MatchRelative().AtName("teams"),
+						path.MatchRelative().AtName("platforms"),
+						path.MatchRelative().AtName("slug_pattern"),
+					),
+				},
+			},
+			"template": schema.SingleNestedAttribute{
+				MarkdownDescription: "The issue alert created on each selected project. See `sentry_issue_alert` for details on each attribute.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"conditions": schema.StringAttribute{
+						MarkdownDescription: "List of conditions. In JSON string format.",
+						Optional:            true,
+						CustomType:          sentrytypes.LossyJsonType{},
+					},
+					"filters": schema.StringAttribute{
+						MarkdownDescription: "A list of filters that determine if a rule fires after the necessary conditions have been met. In JSON string format.",
+						Optional:            true,
+						CustomType:          sentrytypes.LossyJsonType{},
+					},
+					"actions": schema.StringAttribute{
+						MarkdownDescription: "List of actions. In JSON string format.",
+						Required:            true,
+						CustomType:          sentrytypes.LossyJsonType{},
+					},
+					"action_match": schema.StringAttribute{
+						MarkdownDescription: "Trigger actions when an event is captured by Sentry and `any` or `all` of the specified conditions happen.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "any"),
+						},
+					},
+					"filter_match": schema.StringAttribute{
+						MarkdownDescription: "A string determining which filters need to be true before any actions take place. Required when a value is provided for `filters`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "any", "none"),
+						},
+					},
+					"frequency": schema.Int64Attribute{
+						MarkdownDescription: "Perform actions at most once every `X` minutes for this issue.",
+						Required:            true,
+					},
+					"environment": schema.StringAttribute{
+						MarkdownDescription: "Perform issue alert in a specific environment.",
+						Optional:            true,
+					},
+					"owner": schema.StringAttribute{
+						MarkdownDescription: "The team or user that owns the rules. Either `team:<id>`, `team:<slug>`, `user:<id>`, or `user:<email>`.",
+						Optional:            true,
+					},
+				},
+			},
+			"rules": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the issue alerts managed by this policy, keyed by project slug.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// ModifyPlan keeps the managed rules unchanged unless the set of selected
+// projects differs from the projects that already have a rule, or a rule no
+// longer matches the template, in which case the rules are marked as unknown
+// so that the update reconciles them.
+func (r *AlertPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state AlertPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Organization.Equal(state.Organization) || plan.Selector == nil ||
+		plan.Selector.Teams.IsUnknown() || plan.Selector.Platforms.IsUnknown() || plan.Selector.SlugPattern.IsUnknown() {
+		return
+	}
+
+	selector, diags := plan.selector(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := r.selectProjects(ctx, plan.Organization.ValueString(), selector)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error listing projects: %s", err.Error()))
+		return
+	}
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(state.Rules.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	drifted, diags := driftedAlertRulesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := state.Rules
+	if len(projects) != len(managed) || len(drifted) > 0 {
+		rules = types.MapUnknown(types.StringType)
+	}
+	for _, project := range projects {
+		if _, ok := managed[project]; !ok {
+			rules = types.MapUnknown(types.StringType)
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), rules)...)
+}
+
+func (m *AlertPolicyResourceModel) selector(ctx context.Context) (projectSelector, diag.Diagnostics) {
+	var s projectSelector
+	var diags diag.Diagnostics
+
+	if !m.Selector.Teams.IsNull() {
+		diags.Append(m.Selector.Teams.ElementsAs(ctx, &s.teams, false)...)
+	}
+	if !m.Selector.Platforms.IsNull() {
+		diags.Append(m.Selector.Platforms.ElementsAs(ctx, &s.platforms, false)...)
+	}
+	s.slugPattern = m.Selector.SlugPattern.ValueString()
+
+	return s, diags
+}
+
+// selectProjects returns the sorted slugs of the projects matching the selector.
+func (r *AlertPolicyResource) selectProjects(ctx context.Context, organization string, selector projectSelector) ([]string, error) {
+	var slugs []string
+	params := &sentry.ListOrganizationProjectsParams{}
+
+	for {
+		projects, apiResp, err := r.client.OrganizationProjects.List(ctx, organization, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range projects {
+			if selector.Matches(*project) {
+				slugs = append(slugs, project.Slug)
+			}
+		}
+
+		if apiResp.Cursor == "" {
+			break
+		}
+		params.Cursor = apiResp.Cursor
+	}
+
+	sort.Strings(slugs)
+	return slugs, nil
+}
+
+func (r *AlertPolicyResource) alertParams(ctx context.Context, data AlertPolicyResourceModel) (*sentry.IssueAlert, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	template := data.Template
+
+	params := &sentry.IssueAlert{
+		Name:        data.Name.ValueStringPointer(),
+		ActionMatch: template.ActionMatch.ValueStringPointer(),
+		FilterMatch: template.FilterMatch.ValueStringPointer(),
+		Frequency:   sentry.JsonNumber(json.Number(template.Frequency.String())),
+		Environment: template.Environment.ValueStringPointer(),
+	}
+	if !template.Owner.IsNull() {
+		owner, err := sentryclient.ResolveOwner(ctx, r.client, data.Organization.ValueString(), template.Owner.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("template").AtName("owner"), "Invalid Owner", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+			return nil, diags
+		}
+		params.Owner = &owner
+	}
+	if !template.Conditions.IsNull() {
+		diags.Append(template.Conditions.Unmarshal(&params.Conditions)...)
+	}
+	if !template.Filters.IsNull() {
+		diags.Append(template.Filters.Unmarshal(&params.Filters)...)
+	}
+	if !template.Actions.IsNull() {
+		diags.Append(template.Actions.Unmarshal(&params.Actions)...)
+	}
+
+	return params, diags
+}
+
+// ruleMatchesTemplate reports whether a managed rule still matches the template.
+// The JSON attributes are compared like sentrytypes.LossyJson, so the fields
+// that Sentry adds to conditions, filters and actions are ignored. The filter
+// match and owner are only compared when they are set in the template.
+func (r *AlertPolicyResource) ruleMatchesTemplate(ctx context.Context, data AlertPolicyResourceModel, alert sentry.IssueAlert) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	template := data.Template
+
+	frequency, err := alert.Frequency.Int64()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading issue alert frequency: %s", err.Error()))
+		return false, diags
+	}
+
+	if sentry.StringValue(alert.Name) != data.Name.ValueString() ||
+		sentry.StringValue(alert.ActionMatch) != template.ActionMatch.ValueString() ||
+		sentry.StringValue(alert.Environment) != template.Environment.ValueString() ||
+		frequency != template.Frequency.ValueInt64() {
+		return false, diags
+	}
+	if !template.FilterMatch.IsNull() && sentry.StringValue(alert.FilterMatch) != template.FilterMatch.ValueString() {
+		return false, diags
+	}
+
+	if !template.Owner.IsNull() {
+		if alert.Owner == nil {
+			return false, diags
+		}
+		equal, err := sentryclient.OwnerEquals(ctx, r.client, data.Organization.ValueString(), template.Owner.ValueString(), *alert.Owner)
+		if err != nil {
+			diags.AddAttributeError(path.Root("template").AtName("owner"), "Invalid Owner", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+			return false, diags
+		}
+		if !equal {
+			return false, diags
+		}
+	}
+
+	for _, field := range []struct {
+		configured sentrytypes.LossyJson
+		remote     interface{}
+	}{
+		{template.Conditions, alert.Conditions},
+		{template.Filters, alert.Filters},
+		{template.Actions, alert.Actions},
+	} {
+		equal, d := lossyJsonMatches(ctx, field.configured, field.remote)
+		diags.Append(d...)
+		if diags.HasError() || !equal {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// lossyJsonMatches reports whether the remote list contains the configured
+// JSON. An unset or empty configured list matches only an empty remote list.
+func lossyJsonMatches(ctx context.Context, configured sentrytypes.LossyJson, remote interface{}) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, err := json.Marshal(remote)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error encoding issue alert: %s", err.Error()))
+		return false, diags
+	}
+	var items []interface{}
+	if err := json.Unmarshal(b, &items); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error decoding issue alert: %s", err.Error()))
+		return false, diags
+	}
+
+	if configured.IsNull() {
+		return len(items) == 0, diags
+	}
+	if len(items) == 0 {
+		b = []byte("[]")
+	}
+	return sentrytypes.NewLossyJsonValue(string(b)).StringSemanticEquals(ctx, configured)
+}
+
+// reconcile creates or updates the rule on every selected project and deletes
+// the rules of projects that are no longer selected. The returned map holds
+// the rules that exist afterwards, even if an error occurred part way.
+func (r *AlertPolicyResource) reconcile(ctx context.Context, data AlertPolicyResourceModel, managed map[string]string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	organization := data.Organization.ValueString()
+
+	rules := make(map[string]string, len(managed))
+	for project, ruleId := range managed {
+		rules[project] = ruleId
+	}
+
+	selector, d := data.selector(ctx)
+	diags.Append(d...)
+	params, d := r.alertParams(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return rules, diags
+	}
+
+	projects, err := r.selectProjects(ctx, organization, selector)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error listing projects: %s", err.Error()))
+		return rules, diags
+	}
+
+	for _, project := range projects {
+		projectParams := *params
+		projectParams.Projects = []string{project}
+
+		if ruleId, ok := rules[project]; ok {
+			_, apiResp, err := r.client.IssueAlerts.Update(ctx, organization, project, ruleId, &projectParams)
+			if err == nil {
+				continue
+			}
+			if apiResp == nil || apiResp.StatusCode != http.StatusNotFound {
+				diags.AddError("Client Error", fmt.Sprintf("Error updating issue alert on project %s: %s", project, err.Error()))
+				return rules, diags
+			}
+			// The rule was deleted outside of Terraform, so create it again.
+			delete(rules, project)
+		}
+
+		alert, _, err := r.client.IssueAlerts.Create(ctx, organization, project, &projectParams)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Error creating issue alert on project %s: %s", project, err.Error()))
+			return rules, diags
+		}
+		rules[project] = sentry.StringValue(alert.ID)
+	}
+
+	for project, ruleId := range managed {
+		if slices.Contains(projects, project) {
+			continue
+		}
+
+		apiResp, err := r.client.IssueAlerts.Delete(ctx, organization, project, ruleId)
+		if err != nil && (apiResp == nil || apiResp.StatusCode != http.StatusNotFound) {
+			diags.AddError("Client Error", fmt.Sprintf("Error deleting issue alert on project %s: %s", project, err.Error()))
+			return rules, diags
+		}
+		delete(rules, project)
+	}
+
+	return rules, diags
+}
+
+func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := r.reconcile(ctx, data, map[string]string{})
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() && len(rules) == 0 {
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), rules); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling alert policy: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := make(map[string]string, len(managed))
+	var drifted []string
+	for project, ruleId := range managed {
+		alert, apiResp, err := r.client.IssueAlerts.Get(ctx, data.Organization.ValueString(), project, ruleId)
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			// Forget rules deleted outside of Terraform so they are recreated.
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading issue alert on project %s: %s", project, err.Error()))
+			return
+		}
+		rules[project] = ruleId
+
+		matches, diags := r.ruleMatchesTemplate(ctx, data, *alert)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !matches {
+			drifted = append(drifted, project)
+		}
+	}
+
+	var driftedValue []byte
+	if len(drifted) > 0 {
+		sort.Strings(drifted)
+		driftedValue = must.Get(json.Marshal(drifted))
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyDriftedAlertRules, driftedValue)...)
+
+	if err := data.Fill(data.Organization.ValueString(), rules); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling alert policy: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(state.Rules.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := r.reconcile(ctx, data, managed)
+	resp.Diagnostics.Append(diags...)
+	if !diags.HasError() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyDriftedAlertRules, nil)...)
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), rules); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling alert policy: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for project, ruleId := range managed {
+		apiResp, err := r.client.IssueAlerts.Delete(ctx, data.Organization.ValueString(), project, ruleId)
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting issue alert on project %s: %s", project, err.Error()))
+			return
+		}
+	}
+}
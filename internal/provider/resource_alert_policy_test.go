@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestProjectSelectorMatches(t *testing.T) {
+	t.Parallel()
+
+	project := sentry.Project{
+		Slug:     "backend-api",
+		Platform: "go",
+		Teams: []sentry.Team{
+			{Slug: sentry.String("payments")},
+			{Slug: sentry.String("platform")},
+		},
+	}
+
+	testCases := map[string]struct {
+		selector projectSelector
+		expected bool
+	}{
+		"team":                 {selector: projectSelector{teams: []string{"payments"}}, expected: true},
+		"other team":           {selector: projectSelector{teams: []string{"growth"}}, expected: false},
+		"platform":             {selector: projectSelector{platforms: []string{"python", "go"}}, expected: true},
+		"other platform":       {selector: projectSelector{platforms: []string{"python"}}, expected: false},
+		"slug pattern":         {selector: projectSelector{slugPattern: "backend-*"}, expected: true},
+		"other slug pattern":   {selector: projectSelector{slugPattern: "frontend-*"}, expected: false},
+		"all criteria":         {selector: projectSelector{teams: []string{"platform"}, platforms: []string{"go"}, slugPattern: "*-api"}, expected: true},
+		"one criterion fails":  {selector: projectSelector{teams: []string{"platform"}, platforms: []string{"python"}}, expected: false},
+		"invalid slug pattern": {selector: projectSelector{slugPattern: "backend-["}, expected: false},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.selector.Matches(project); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccAlertPolicyResource(t *testing.T) {
+	rn := "sentry_alert_policy.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project1 := acctest.RandomWithPrefix("tf-project")
+	project2 := acctest.RandomWithPrefix("tf-project")
+	policy := acctest.RandomWithPrefix("tf-alert-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertPolicyResourceConfig(team, []string{project1}, policy),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(policy)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtMapKey(project1), knownvalue.NotNull()),
+				},
+			},
+			{
+				// A rule edited in the Sentry UI is rewritten to match the
+				// template.
+				PreConfig: func() {
+					ctx := context.Background()
+					alert, err := sentryclient.FindIssueAlertByName(ctx, acctest.SharedClient, acctest.TestOrganization, project1, policy)
+					if err != nil {
+						t.Fatal(err)
+					}
+					alert.Frequency = sentry.JsonNumber(json.Number("60"))
+					if _, _, err := acctest.SharedClient.IssueAlerts.Update(ctx, acctest.TestOrganization, project1, sentry.StringValue(alert.ID), alert); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAlertPolicyResourceConfig(team, []string{project1}, policy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rn, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(rn, tfjsonpath.New("rules")),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					alert, err := sentryclient.FindIssueAlertByName(context.Background(), acctest.SharedClient, acctest.TestOrganization, project1, policy)
+					if err != nil {
+						return err
+					}
+					if frequency := alert.Frequency.String(); frequency != "30" {
+						return fmt.Errorf("expected frequency 30, got %s", frequency)
+					}
+					return nil
+				},
+			},
+			{
+				// The new project is created after the policy is planned, so
+				// the policy only picks it up on the next plan.
+				Config:             testAccAlertPolicyResourceConfig(team, []string{project1}, policy) + testAccAlertPolicyResourceProjectConfig(1, project2),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAlertPolicyResourceConfig(team, []string{project1}, policy) + testAccAlertPolicyResourceProjectConfig(1, project2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rn, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(rn, tfjsonpath.New("rules")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtMapKey(project1), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtMapKey(project2), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccAlertPolicyResourceConfig(team, []string{project1, project2}, policy),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtMapKey(project1), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtMapKey(project2), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccAlertPolicyResourceConfig(team, []string{project2}, policy+"-updated"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(policy+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtMapKey(project2), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccAlertPolicyResourceConfig(teamName string, projectNames []string, policyName string) string {
+	config := testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.id
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+`, teamName)
+
+	dependsOn := ""
+	for i, projectName := range projectNames {
+		config += testAccAlertPolicyResourceProjectConfig(i, projectName)
+		dependsOn += fmt.Sprintf("sentry_project.test_%d, ", i)
+	}
+
+	return config + fmt.Sprintf(`
+resource "sentry_alert_policy" "test" {
+	organization = sentry_team.test.organization
+	name         = "%[1]s"
+
+	selector = {
+		teams = [sentry_team.test.slug]
+	}
+
+	template = {
+		action_match = "any"
+		frequency    = 30
+
+		conditions = <<EOT
+[
+	{
+		"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"
+	},
+	{
+		"id": "sentry.rules.conditions.regression_event.RegressionEventCondition"
+	}
+]
+EOT
+
+		actions = <<EOT
+[
+	{
+		"id": "sentry.mail.actions.NotifyEmailAction",
+		"targetType": "IssueOwners"
+	}
+]
+EOT
+	}
+
+	depends_on = [%[2]s]
+}
+`, policyName, dependsOn)
+}
+
+func testAccAlertPolicyResourceProjectConfig(index int, projectName string) string {
+	return fmt.Sprintf(`
+resource "sentry_project" "test_%[1]d" {
+	organization = sentry_team.test.organization
+	teams        = [sentry_team.test.id]
+	name         = "%[2]s"
+	platform     = "go"
+}
+`, index, projectName)
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &AlertSnoozeResource{}
+var _ resource.ResourceWithConfigure = &AlertSnoozeResource{}
+var _ resource.ResourceWithConfigValidators = &AlertSnoozeResource{}
+
+func NewAlertSnoozeResource() resource.Resource {
+	return &AlertSnoozeResource{}
+}
+
+type AlertSnoozeResource struct {
+	baseResource
+}
+
+type AlertSnoozeResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Organization  types.String `tfsdk:"organization"`
+	Project       types.String `tfsdk:"project"`
+	IssueAlertId  types.String `tfsdk:"issue_alert_id"`
+	MetricAlertId types.String `tfsdk:"metric_alert_id"`
+	Target        types.String `tfsdk:"target"`
+	Until         types.String `tfsdk:"until"`
+}
+
+// rule returns the URL segment and ID of the snoozed alert rule. Metric alerts
+// accept both the `sentry_metric_alert` resource ID and the bare alert ID.
+func (m *AlertSnoozeResourceModel) rule() (string, string, error) {
+	if !m.IssueAlertId.IsNull() {
+		return sentryclient.AlertRuleTypeIssue, m.IssueAlertId.ValueString(), nil
+	}
+
+	alertId := m.MetricAlertId.ValueString()
+	if strings.Contains(alertId, "/") {
+		_, _, id, err := splitSentryAlertID(alertId)
+		if err != nil {
+			return "", "", err
+		}
+		alertId = id
+	}
+	return sentryclient.AlertRuleTypeMetric, alertId, nil
+}
+
+// expired reports whether the snooze has reached its `until` timestamp.
+func (m *AlertSnoozeResourceModel) expired(now time.Time) bool {
+	if m.Until.IsNull() {
+		return false
+	}
+
+	until, err := time.Parse(time.RFC3339, m.Until.ValueString())
+	if err != nil {
+		return false
+	}
+	return !until.After(now)
+}
+
+func (m *AlertSnoozeResourceModel) Fill(status sentryclient.AlertSnoozeStatus) error {
+	if status.SnoozeForEveryone {
+		m.Target = types.StringValue(sentryclient.AlertSnoozeTargetEveryone)
+	} else {
+		m.Target = types.StringValue(sentryclient.AlertSnoozeTargetMe)
+	}
+
+	return nil
+}
+
+func (r *AlertSnoozeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_snooze"
+}
+
+func (r *AlertSnoozeResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("issue_alert_id"),
+			path.MatchRoot("metric_alert_id"),
+		),
+	}
+}
+
+func (r *AlertSnoozeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Alert Snooze resource. Mutes an issue alert or a metric alert, either until a given time or indefinitely. Destroying the resource unmutes the alert. Once an `until` timestamp has passed, Sentry unmutes the alert on its own and the resource is kept in state until it is removed from the configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the alert belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project the alert belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_alert_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue alert to snooze, i.e. the `id` of a `sentry_issue_alert` resource.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metric_alert_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the metric alert to snooze, i.e. the `id` of a `sentry_metric_alert` resource.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Who the alert is muted for. Either `everyone` or `me`, the user the auth token belongs to. Defaults to `everyone`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(sentryclient.AlertSnoozeTargetEveryone),
+				Validators: []validator.String{
+					stringvalidator.OneOf(sentryclient.AlertSnoozeTargetEveryone, sentryclient.AlertSnoozeTargetMe),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "An RFC 3339 timestamp at which the alert is unmuted, e.g. `2024-01-02T15:04:05Z`. The alert is muted indefinitely if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AlertSnoozeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleType, ruleId, err := data.rule()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metric_alert_id"), "Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+
+	params := &sentryclient.AlertSnoozeParams{
+		Target: data.Target.ValueString(),
+	}
+	if !data.Until.IsNull() {
+		until, err := time.Parse(time.RFC3339, data.Until.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("until"), "Invalid Timestamp", fmt.Sprintf("Error parsing timestamp: %s", err.Error()))
+			return
+		}
+		params.Until = &until
+	}
+
+	_, _, err = sentryclient.SnoozeAlert(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		ruleType,
+		ruleId,
+		params,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error snoozing alert: %s", err.Error()))
+		return
+	}
+
+	data.Id = types.StringValue(buildThreePartID(data.Organization.ValueString(), data.Project.ValueString(), ruleId))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertSnoozeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleType, ruleId, err := data.rule()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+
+	status, apiResp, err := sentryclient.GetAlertSnoozeStatus(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		ruleType,
+		ruleId,
+	)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Alert not found: %s", err.Error()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading alert: %s", err.Error()))
+		return
+	}
+
+	if !status.Snooze {
+		// An expired snooze is expected and should not be recreated, whereas a
+		// snooze removed outside of Terraform should be.
+		if !data.expired(time.Now()) {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	if err := data.Fill(*status); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling alert snooze: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertSnoozeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AlertSnoozeResourceModel
+
+	// All attributes require replacement, so there is nothing to update.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertSnoozeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleType, ruleId, err := data.rule()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+
+	apiResp, err := sentryclient.UnsnoozeAlert(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		ruleType,
+		ruleId,
+		data.Target.ValueString(),
+	)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error unsnoozing alert: %s", err.Error()))
+		return
+	}
+}
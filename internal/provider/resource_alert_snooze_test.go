@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccAlertSnoozeResource(t *testing.T) {
+	rn := "sentry_alert_snooze.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+	alert := acctest.RandomWithPrefix("tf-issue-alert")
+	until := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertSnoozeResourceConfig(team, project, alert, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("target"), knownvalue.StringExact("everyone")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("until"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccAlertSnoozeResourceConfig(team, project, alert, fmt.Sprintf(`until = "%s"`, until)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("target"), knownvalue.StringExact("everyone")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("until"), knownvalue.StringExact(until)),
+				},
+			},
+		},
+	})
+}
+
+func testAccAlertSnoozeResourceConfig(teamName string, projectName string, alertName string, extras string) string {
+	return testAccIssueAlertConfigOwner(teamName, projectName, alertName, "null") + fmt.Sprintf(`
+resource "sentry_alert_snooze" "test" {
+	organization   = sentry_issue_alert.test.organization
+	project        = sentry_issue_alert.test.project
+	issue_alert_id = sentry_issue_alert.test.id
+	%[1]s
+}
+`, extras)
+}
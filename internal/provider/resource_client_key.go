@@ -6,12 +6,19 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
 	"github.com/jianyuan/go-sentry/v2/sentry"
 )
 
@@ -41,6 +48,9 @@ type ClientKeyResourceModel struct {
 	DsnPublic       types.String `tfsdk:"dsn_public"`
 	DsnSecret       types.String `tfsdk:"dsn_secret"`
 	DsnCsp          types.String `tfsdk:"dsn_csp"`
+
+	JavascriptLoaderScript    types.Object `tfsdk:"javascript_loader_script"`
+	JavascriptLoaderScriptUrl types.String `tfsdk:"javascript_loader_script_url"`
 }
 
 func (m *ClientKeyResourceModel) Fill(organization string, project string, key sentry.ProjectKey) error {
@@ -64,9 +74,73 @@ func (m *ClientKeyResourceModel) Fill(organization string, project string, key s
 	m.DsnSecret = types.StringValue(key.DSN.Secret)
 	m.DsnCsp = types.StringValue(key.DSN.CSP)
 
+	m.JavascriptLoaderScript = clientKeyJavascriptLoaderScriptValue(key)
+	m.JavascriptLoaderScriptUrl = types.StringValue(key.DSN.CDN)
+
 	return nil
 }
 
+// loaderParams returns the JavaScript Loader Script settings to send to
+// Sentry, or nil when none are configured.
+func (m ClientKeyResourceModel) loaderParams(ctx context.Context) (*sentryclient.UpdateProjectKeyParams, diag.Diagnostics) {
+	if m.JavascriptLoaderScript.IsNull() || m.JavascriptLoaderScript.IsUnknown() {
+		return nil, nil
+	}
+
+	var loader ClientKeyJavascriptLoaderScriptModel
+	diags := m.JavascriptLoaderScript.As(ctx, &loader, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	knownBool := func(v types.Bool) *bool {
+		if v.IsUnknown() {
+			return nil
+		}
+		return v.ValueBoolPointer()
+	}
+
+	params := &sentryclient.UpdateProjectKeyParams{
+		DynamicSdkLoaderOptions: &sentryclient.ProjectKeyDynamicSdkLoaderOptionsParams{
+			HasPerformance: knownBool(loader.PerformanceMonitoringEnabled),
+			HasReplay:      knownBool(loader.SessionReplayEnabled),
+			HasDebug:       knownBool(loader.DebugEnabled),
+		},
+	}
+	if !loader.BrowserSdkVersion.IsUnknown() {
+		params.BrowserSdkVersion = loader.BrowserSdkVersion.ValueStringPointer()
+	}
+	return params, diags
+}
+
+type ClientKeyJavascriptLoaderScriptModel struct {
+	BrowserSdkVersion            types.String `tfsdk:"browser_sdk_version"`
+	PerformanceMonitoringEnabled types.Bool   `tfsdk:"performance_monitoring_enabled"`
+	SessionReplayEnabled         types.Bool   `tfsdk:"session_replay_enabled"`
+	DebugEnabled                 types.Bool   `tfsdk:"debug_enabled"`
+}
+
+func (m ClientKeyJavascriptLoaderScriptModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"browser_sdk_version":            types.StringType,
+		"performance_monitoring_enabled": types.BoolType,
+		"session_replay_enabled":         types.BoolType,
+		"debug_enabled":                  types.BoolType,
+	}
+}
+
+func clientKeyJavascriptLoaderScriptValue(key sentry.ProjectKey) types.Object {
+	return types.ObjectValueMust(
+		ClientKeyJavascriptLoaderScriptModel{}.AttributeTypes(),
+		map[string]attr.Value{
+			"browser_sdk_version":            types.StringValue(key.BrowserSDKVersion),
+			"performance_monitoring_enabled": types.BoolValue(key.DynamicSDKLoaderOptions.HasPerformance),
+			"session_replay_enabled":         types.BoolValue(key.DynamicSDKLoaderOptions.HasReplay),
+			"debug_enabled":                  types.BoolValue(key.DynamicSDKLoaderOptions.HasDebugFiles),
+		},
+	)
+}
+
 func (r *ClientKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_key"
 }
@@ -136,6 +210,55 @@ func (r *ClientKeyResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Security header endpoint for features like CSP and Expect-CT reports.",
 				Computed:            true,
 			},
+			"javascript_loader_script": schema.SingleNestedAttribute{
+				MarkdownDescription: "The settings of the JavaScript Loader Script of the key. Settings that are not configured are left as they are in Sentry.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"browser_sdk_version": schema.StringAttribute{
+						MarkdownDescription: "The version of the browser SDK to load, e.g. `latest` or `8.x`.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"performance_monitoring_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the loaded SDK has performance monitoring enabled.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"session_replay_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the loaded SDK has session replay enabled.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"debug_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the loaded SDK is the debug bundle.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"javascript_loader_script_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the JavaScript Loader Script of the key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -166,6 +289,28 @@ func (r *ClientKeyResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Create error: %s", err.Error()))
 		return
 	}
+
+	// The loader settings can only be set once the key exists.
+	loaderParams, diags := data.loaderParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if loaderParams != nil {
+		key, _, err = sentryclient.UpdateProjectKey(
+			ctx,
+			r.client,
+			data.Organization.ValueString(),
+			data.Project.ValueString(),
+			key.ID,
+			loaderParams,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Update error: %s", err.Error()))
+			return
+		}
+	}
+
 	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *key); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
 		return
@@ -218,16 +363,23 @@ func (r *ClientKeyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	params := &sentry.UpdateProjectKeyParams{
-		Name: data.Name.ValueString(),
-		RateLimit: &sentry.ProjectKeyRateLimit{
-			Window: int(data.RateLimitWindow.ValueInt64()),
-			Count:  int(data.RateLimitCount.ValueInt64()),
-		},
+	params, diags := data.loaderParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if params == nil {
+		params = &sentryclient.UpdateProjectKeyParams{}
+	}
+	params.Name = data.Name.ValueString()
+	params.RateLimit = &sentry.ProjectKeyRateLimit{
+		Window: int(data.RateLimitWindow.ValueInt64()),
+		Count:  int(data.RateLimitCount.ValueInt64()),
 	}
 
-	key, apiResp, err := r.client.ProjectKeys.Update(
+	key, apiResp, err := sentryclient.UpdateProjectKey(
 		ctx,
+		r.client,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
 		data.Id.ValueString(),
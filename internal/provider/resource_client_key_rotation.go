@@ -0,0 +1,625 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+var _ resource.Resource = &ClientKeyRotationResource{}
+var _ resource.ResourceWithConfigure = &ClientKeyRotationResource{}
+var _ resource.ResourceWithImportState = &ClientKeyRotationResource{}
+var _ resource.ResourceWithModifyPlan = &ClientKeyRotationResource{}
+
+// The grace stages of the previous key after a rotation.
+const (
+	keyRotationStageActive   = "active"
+	keyRotationStageDisabled = "disabled"
+	keyRotationStageDeleted  = "deleted"
+)
+
+// privateKeyStaleClientKeys holds the IDs of the keys that a rotation dropped
+// but could not delete yet. They are deleted by the next update.
+const privateKeyStaleClientKeys = "stale_client_keys"
+
+func staleClientKeysFromPrivate(ctx context.Context, private privateStateGetter) ([]string, diag.Diagnostics) {
+	var ids []string
+
+	value, diags := private.GetKey(ctx, privateKeyStaleClientKeys)
+	if diags.HasError() || len(value) == 0 {
+		return ids, diags
+	}
+
+	if err := json.Unmarshal(value, &ids); err != nil {
+		diags.AddError("Private State Error", fmt.Sprintf("Error reading the stale client keys: %s", err.Error()))
+	}
+	return ids, diags
+}
+
+func NewClientKeyRotationResource() resource.Resource {
+	return &ClientKeyRotationResource{}
+}
+
+type ClientKeyRotationResource struct {
+	baseResource
+}
+
+type ClientKeyRotationResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Organization     types.String `tfsdk:"organization"`
+	Project          types.String `tfsdk:"project"`
+	Name             types.String `tfsdk:"name"`
+	RotationTrigger  types.String `tfsdk:"rotation_trigger"`
+	PreviousKeyStage types.String `tfsdk:"previous_key_stage"`
+	CurrentKey       types.Object `tfsdk:"current_key"`
+	PreviousKey      types.Object `tfsdk:"previous_key"`
+}
+
+func (m *ClientKeyRotationResourceModel) Fill(current sentry.ProjectKey, previous *sentry.ProjectKey) error {
+	m.Name = types.StringValue(current.Name)
+	m.CurrentKey = clientKeyRotationKeyValue(current)
+	if previous == nil {
+		m.PreviousKey = types.ObjectNull(ClientKeyRotationKeyModel{}.AttributeTypes())
+	} else {
+		m.PreviousKey = clientKeyRotationKeyValue(*previous)
+	}
+
+	return nil
+}
+
+// rotates reports whether the plan rotates the keys. Setting the trigger for
+// the first time, e.g. after an import, or removing it does not rotate them.
+// An unknown trigger may rotate them.
+func (m ClientKeyRotationResourceModel) rotates(state ClientKeyRotationResourceModel) bool {
+	return !state.RotationTrigger.IsNull() && !m.RotationTrigger.IsNull() && !m.RotationTrigger.Equal(state.RotationTrigger)
+}
+
+type ClientKeyRotationKeyModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	IsActive  types.Bool   `tfsdk:"is_active"`
+	Public    types.String `tfsdk:"public"`
+	Secret    types.String `tfsdk:"secret"`
+	DsnPublic types.String `tfsdk:"dsn_public"`
+	DsnSecret types.String `tfsdk:"dsn_secret"`
+	DsnCsp    types.String `tfsdk:"dsn_csp"`
+}
+
+func (m ClientKeyRotationKeyModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"name":       types.StringType,
+		"is_active":  types.BoolType,
+		"public":     types.StringType,
+		"secret":     types.StringType,
+		"dsn_public": types.StringType,
+		"dsn_secret": types.StringType,
+		"dsn_csp":    types.StringType,
+	}
+}
+
+func clientKeyRotationKeyValue(key sentry.ProjectKey) types.Object {
+	return types.ObjectValueMust(
+		ClientKeyRotationKeyModel{}.AttributeTypes(),
+		map[string]attr.Value{
+			"id":         types.StringValue(key.ID),
+			"name":       types.StringValue(key.Name),
+			"is_active":  types.BoolValue(key.IsActive),
+			"public":     types.StringValue(key.Public),
+			"secret":     types.StringValue(key.Secret),
+			"dsn_public": types.StringValue(key.DSN.Public),
+			"dsn_secret": types.StringValue(key.DSN.Secret),
+			"dsn_csp":    types.StringValue(key.DSN.CSP),
+		},
+	)
+}
+
+// clientKeyRotationKeyId returns the ID of a key object, or an empty string
+// if there is no key.
+func clientKeyRotationKeyId(key types.Object) string {
+	if key.IsNull() || key.IsUnknown() {
+		return ""
+	}
+	id, _ := key.Attributes()["id"].(types.String)
+	return id.ValueString()
+}
+
+func (r *ClientKeyRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_rotation"
+}
+
+func (r *ClientKeyRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	keyAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the key.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the key.",
+			Computed:            true,
+		},
+		"is_active": schema.BoolAttribute{
+			MarkdownDescription: "Whether the key accepts events.",
+			Computed:            true,
+		},
+		"public": schema.StringAttribute{
+			MarkdownDescription: "The public key.",
+			Computed:            true,
+		},
+		"secret": schema.StringAttribute{
+			MarkdownDescription: "The secret key.",
+			Computed:            true,
+		},
+		"dsn_public": schema.StringAttribute{
+			MarkdownDescription: "The DSN tells the SDK where to send the events to.",
+			Computed:            true,
+		},
+		"dsn_secret": schema.StringAttribute{
+			MarkdownDescription: "Deprecated DSN includes a secret which is no longer required by newer SDK versions.",
+			Computed:            true,
+		},
+		"dsn_csp": schema.StringAttribute{
+			MarkdownDescription: "Security header endpoint for features like CSP and Expect-CT reports.",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rotate the client key of a project without downtime. The resource owns the current key and, after a rotation, the previous key.\n\n" +
+			"Changing `rotation_trigger` creates a new current key. The former current key becomes the previous key and stays active, and any older previous key is deleted. " +
+			"The previous key then goes through the grace stages set by `previous_key_stage`: keep it `active` while the apps roll out the new DSN, `disabled` to check that nothing still uses it, and `deleted` once the rotation is done. " +
+			"A rotation requires `previous_key_stage` to be `active`, so set it back to `active` in the same change as `rotation_trigger`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, made of the organization, the project and the ID of the current key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the resource belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project the resource belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the current key. New keys are created with this name.",
+				Required:            true,
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value, e.g. a date or an incident ID. Changing it rotates the keys. Setting it for the first time or removing it does not.",
+				Optional:            true,
+			},
+			"previous_key_stage": schema.StringAttribute{
+				MarkdownDescription: "The grace stage of the previous key. One of `active`, `disabled` or `deleted`. Defaults to `active`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(keyRotationStageActive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						keyRotationStageActive,
+						keyRotationStageDisabled,
+						keyRotationStageDeleted,
+					),
+				},
+			},
+			"current_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "The key that the apps should use.",
+				Computed:            true,
+				Attributes:          keyAttributes,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "The key that was current before the last rotation, until it is deleted.",
+				Computed:            true,
+				Attributes:          keyAttributes,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan marks the keys that the update changes as unknown.
+func (r *ClientKeyRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ClientKeyRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The former current key becomes the previous key and must keep accepting
+	// events until the apps use the new key.
+	if plan.rotates(state) && !plan.PreviousKeyStage.IsUnknown() && plan.PreviousKeyStage.ValueString() != keyRotationStageActive {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("previous_key_stage"),
+			"Invalid Rotation",
+			fmt.Sprintf("Changing `rotation_trigger` requires `previous_key_stage` to be %q, got %q. Set it to %q in the same change so that the current key stays active while the apps roll out the new DSN.", keyRotationStageActive, plan.PreviousKeyStage.ValueString(), keyRotationStageActive),
+		)
+		return
+	}
+
+	staleIds, diags := staleClientKeysFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyTypes := ClientKeyRotationKeyModel{}.AttributeTypes()
+	if plan.rotates(state) {
+		plan.Id = types.StringUnknown()
+	}
+	if plan.rotates(state) || !plan.Name.Equal(state.Name) {
+		plan.CurrentKey = types.ObjectUnknown(keyTypes)
+	}
+	if plan.rotates(state) || !plan.PreviousKeyStage.Equal(state.PreviousKeyStage) || len(staleIds) > 0 {
+		plan.PreviousKey = types.ObjectUnknown(keyTypes)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ClientKeyRotationResource) deleteKey(ctx context.Context, organization string, project string, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiResp, err := r.client.ProjectKeys.Delete(ctx, organization, project, id)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Delete error: %s", err.Error()))
+	}
+	return diags
+}
+
+// deleteStaleKeys deletes the given keys and returns the ones that are left.
+func (r *ClientKeyRotationResource) deleteStaleKeys(ctx context.Context, organization string, project string, ids []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for i, id := range ids {
+		tflog.Debug(ctx, "Deleting stale client key", map[string]interface{}{
+			"org":     organization,
+			"project": project,
+			"key":     id,
+		})
+		diags.Append(r.deleteKey(ctx, organization, project, id)...)
+		if diags.HasError() {
+			return ids[i:], diags
+		}
+	}
+	return nil, diags
+}
+
+// applyPreviousKeyStage moves the previous key to the configured stage and
+// returns its ID, which is empty once the key is deleted.
+func (r *ClientKeyRotationResource) applyPreviousKeyStage(ctx context.Context, data *ClientKeyRotationResourceModel, previousId string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if previousId == "" {
+		return "", diags
+	}
+
+	tflog.Debug(ctx, "Moving previous client key to its grace stage", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Project.ValueString(),
+		"key":     previousId,
+		"stage":   data.PreviousKeyStage.ValueString(),
+	})
+
+	if data.PreviousKeyStage.ValueString() == keyRotationStageDeleted {
+		diags.Append(r.deleteKey(ctx, data.Organization.ValueString(), data.Project.ValueString(), previousId)...)
+		if diags.HasError() {
+			return previousId, diags
+		}
+		return "", diags
+	}
+
+	isActive := data.PreviousKeyStage.ValueString() == keyRotationStageActive
+	_, _, err := sentryclient.UpdateProjectKey(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		previousId,
+		&sentryclient.UpdateProjectKeyParams{
+			IsActive: &isActive,
+		},
+	)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Update error: %s", err.Error()))
+	}
+	return previousId, diags
+}
+
+// readKeys fills the model with the current and previous keys. It reports
+// whether the current key still exists.
+func (r *ClientKeyRotationResource) readKeys(ctx context.Context, data *ClientKeyRotationResourceModel, currentId string, previousId string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, apiResp, err := r.client.ProjectKeys.Get(ctx, data.Organization.ValueString(), data.Project.ValueString(), currentId)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return false, diags
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Read error: %s", err.Error()))
+		return true, diags
+	}
+
+	var previous *sentry.ProjectKey
+	if previousId != "" {
+		previous, apiResp, err = r.client.ProjectKeys.Get(ctx, data.Organization.ValueString(), data.Project.ValueString(), previousId)
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			previous = nil
+		} else if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Read error: %s", err.Error()))
+			return true, diags
+		}
+	}
+
+	if err := data.Fill(*current, previous); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+	}
+	return true, diags
+}
+
+func (r *ClientKeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClientKeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, _, err := r.client.ProjectKeys.Create(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		&sentry.CreateProjectKeyParams{
+			Name: data.Name.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Create error: %s", err.Error()))
+		return
+	}
+
+	data.Id = types.StringValue(buildThreePartID(data.Organization.ValueString(), data.Project.ValueString(), key.ID))
+	if err := data.Fill(*key, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientKeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClientKeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readKeys(ctx, &data, clientKeyRotationKeyId(data.CurrentKey), clientKeyRotationKeyId(data.PreviousKey))
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Current key not found: %s", clientKeyRotationKeyId(data.CurrentKey)))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientKeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ClientKeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+	project := plan.Project.ValueString()
+	currentId := clientKeyRotationKeyId(state.CurrentKey)
+	previousId := clientKeyRotationKeyId(state.PreviousKey)
+
+	staleIds, diags := staleClientKeysFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.CurrentKey = state.CurrentKey
+	plan.PreviousKey = state.PreviousKey
+
+	if plan.rotates(state) {
+		tflog.Debug(ctx, "Rotating client key", map[string]interface{}{
+			"org":     organization,
+			"project": project,
+			"key":     currentId,
+		})
+
+		key, _, err := r.client.ProjectKeys.Create(
+			ctx,
+			organization,
+			project,
+			&sentry.CreateProjectKeyParams{
+				Name: plan.Name.ValueString(),
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Create error: %s", err.Error()))
+			return
+		}
+
+		// Only one previous key is kept. The older one is recorded before it
+		// is deleted so that a failed delete is retried by the next update.
+		if previousId != "" {
+			staleIds = append(staleIds, previousId)
+		}
+		previousId, currentId = currentId, key.ID
+
+		plan.Id = types.StringValue(buildThreePartID(organization, project, currentId))
+		plan.CurrentKey = clientKeyRotationKeyValue(*key)
+		plan.PreviousKey = state.CurrentKey
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyStaleClientKeys, must.Get(json.Marshal(staleIds)))...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if !plan.Name.Equal(state.Name) {
+		_, _, err := sentryclient.UpdateProjectKey(
+			ctx,
+			r.client,
+			organization,
+			project,
+			currentId,
+			&sentryclient.UpdateProjectKeyParams{
+				Name: plan.Name.ValueString(),
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Update error: %s", err.Error()))
+			return
+		}
+	}
+
+	if len(staleIds) > 0 {
+		staleIds, diags = r.deleteStaleKeys(ctx, organization, project, staleIds)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyStaleClientKeys, must.Get(json.Marshal(staleIds)))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// A rotation leaves the new previous key active. Only a later change of
+	// the stage disables or deletes it.
+	if !plan.rotates(state) {
+		previousId, diags = r.applyPreviousKeyStage(ctx, &plan, previousId)
+		resp.Diagnostics.Append(diags...)
+		if previousId == "" {
+			plan.PreviousKey = types.ObjectNull(ClientKeyRotationKeyModel{}.AttributeTypes())
+		}
+		if resp.Diagnostics.HasError() {
+			// Keep the prior stage so that the next apply retries it.
+			plan.PreviousKeyStage = state.PreviousKeyStage
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	found, diags := r.readKeys(ctx, &plan, currentId, previousId)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Current key not found: %s", currentId))
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ClientKeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClientKeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staleIds, diags := staleClientKeysFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range append(staleIds, clientKeyRotationKeyId(data.PreviousKey), clientKeyRotationKeyId(data.CurrentKey)) {
+		if id == "" {
+			continue
+		}
+		resp.Diagnostics.Append(r.deleteKey(ctx, data.Organization.ValueString(), data.Project.ValueString(), id)...)
+	}
+}
+
+func (r *ClientKeyRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, id, err := splitThreePartID(req.ID, "organization", "project-slug", "key-id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+
+	keyTypes := ClientKeyRotationKeyModel{}.AttributeTypes()
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("previous_key_stage"), keyRotationStageActive,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("current_key"), types.ObjectValueMust(keyTypes, map[string]attr.Value{
+			"id":         types.StringValue(id),
+			"name":       types.StringNull(),
+			"is_active":  types.BoolNull(),
+			"public":     types.StringNull(),
+			"secret":     types.StringNull(),
+			"dsn_public": types.StringNull(),
+			"dsn_secret": types.StringNull(),
+			"dsn_csp":    types.StringNull(),
+		}),
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("previous_key"), types.ObjectNull(keyTypes),
+	)...)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccClientKeyRotationResource(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	keyName := acctest.RandomWithPrefix("tf-key")
+	rn := "sentry_key_rotation.test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(projectName)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("current_key").AtMapKey("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("current_key").AtMapKey("is_active"), knownvalue.Bool(true)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("current_key").AtMapKey("dsn_public"), knownvalue.NotNull()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientKeyRotationResourceConfig(teamName, projectName, keyName, "v1", "active"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(keyName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key"), knownvalue.Null()),
+				),
+			},
+			{
+				Config: testAccClientKeyRotationResourceConfig(teamName, projectName, keyName, "v2", "active"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key").AtMapKey("is_active"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key").AtMapKey("dsn_public"), knownvalue.NotNull()),
+				),
+			},
+			{
+				Config: testAccClientKeyRotationResourceConfig(teamName, projectName, keyName, "v2", "disabled"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key").AtMapKey("is_active"), knownvalue.Bool(false)),
+				),
+			},
+			{
+				Config: testAccClientKeyRotationResourceConfig(teamName, projectName, keyName, "v2", "deleted"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key"), knownvalue.Null()),
+				),
+			},
+			{
+				Config: testAccClientKeyRotationResourceConfig(teamName, projectName, keyName+"-renamed", "v2", "deleted"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(keyName+"-renamed")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("current_key").AtMapKey("name"), knownvalue.StringExact(keyName+"-renamed")),
+				),
+			},
+			{
+				Config:      testAccClientKeyRotationResourceConfig(teamName, projectName, keyName+"-renamed", "v3", "deleted"),
+				ExpectError: regexp.MustCompile("Invalid Rotation"),
+			},
+			{
+				Config: testAccClientKeyRotationResourceConfig(teamName, projectName, keyName+"-renamed", "v3", "active"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key").AtMapKey("is_active"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("previous_key").AtMapKey("name"), knownvalue.StringExact(keyName+"-renamed")),
+				),
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", rn)
+					}
+					organization := rs.Primary.Attributes["organization"]
+					project := rs.Primary.Attributes["project"]
+					keyId := rs.Primary.Attributes["current_key.id"]
+					return buildThreePartID(organization, project, keyId), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger", "previous_key_stage", "previous_key"},
+			},
+		},
+	})
+}
+
+func testAccClientKeyRotationResourceConfig(teamName, projectName, keyName, trigger, stage string) string {
+	return testAccProjectResourceConfig(teamName, projectName) + fmt.Sprintf(`
+resource "sentry_key_rotation" "test" {
+	organization       = sentry_project.test.organization
+	project            = sentry_project.test.id
+	name               = "%[1]s"
+	rotation_trigger   = "%[2]s"
+	previous_key_stage = "%[3]s"
+}
+`, keyName, trigger, stage)
+}
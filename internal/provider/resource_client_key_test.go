@@ -71,6 +71,7 @@ func TestAccClientKeyResource(t *testing.T) {
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_public"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_secret"), knownvalue.NotNull()),
 		statecheck.ExpectKnownValue(rn, tfjsonpath.New("dsn_csp"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script_url"), knownvalue.NotNull()),
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -128,6 +129,41 @@ func TestAccClientKeyResource(t *testing.T) {
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rate_limit_count"), knownvalue.Null()),
 				),
 			},
+			{
+				Config: testAccClientKeyResourceConfig(teamName, projectName, keyName+"-renamed", `
+					javascript_loader_script = {
+						browser_sdk_version            = "7.x"
+						performance_monitoring_enabled = true
+						session_replay_enabled         = false
+						debug_enabled                  = true
+					}
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"browser_sdk_version":            knownvalue.StringExact("7.x"),
+						"performance_monitoring_enabled": knownvalue.Bool(true),
+						"session_replay_enabled":         knownvalue.Bool(false),
+						"debug_enabled":                  knownvalue.Bool(true),
+					})),
+				),
+			},
+			{
+				Config: testAccClientKeyResourceConfig(teamName, projectName, keyName+"-renamed", `
+					javascript_loader_script = {
+						debug_enabled = false
+					}
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("javascript_loader_script"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"browser_sdk_version":            knownvalue.StringExact("7.x"),
+						"performance_monitoring_enabled": knownvalue.Bool(true),
+						"session_replay_enabled":         knownvalue.Bool(false),
+						"debug_enabled":                  knownvalue.Bool(false),
+					})),
+				),
+			},
 			{
 				ResourceName: rn,
 				ImportState:  true,
@@ -0,0 +1,462 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &DetectorResource{}
+var _ resource.ResourceWithConfigure = &DetectorResource{}
+var _ resource.ResourceWithImportState = &DetectorResource{}
+var _ resource.ResourceWithMoveState = &DetectorResource{}
+
+// detectorTypeMetricIssue is the only detector type managed by this resource.
+const detectorTypeMetricIssue = "metric_issue"
+
+func NewDetectorResource() resource.Resource {
+	return &DetectorResource{}
+}
+
+type DetectorResource struct {
+	baseResource
+}
+
+type DetectorDataSourceModel struct {
+	Query       types.String `tfsdk:"query"`
+	Aggregate   types.String `tfsdk:"aggregate"`
+	TimeWindow  types.Int64  `tfsdk:"time_window"`
+	Environment types.String `tfsdk:"environment"`
+	Dataset     types.String `tfsdk:"dataset"`
+	EventTypes  types.Set    `tfsdk:"event_types"`
+}
+
+type DetectorResourceModel struct {
+	Id              types.String             `tfsdk:"id"`
+	Organization    types.String             `tfsdk:"organization"`
+	Project         types.String             `tfsdk:"project"`
+	Name            types.String             `tfsdk:"name"`
+	Enabled         types.Bool               `tfsdk:"enabled"`
+	Owner           types.String             `tfsdk:"owner"`
+	DetectionType   types.String             `tfsdk:"detection_type"`
+	ComparisonDelta types.Int64              `tfsdk:"comparison_delta"`
+	DataSource      *DetectorDataSourceModel `tfsdk:"data_source"`
+	ConditionGroup  *DataConditionGroupModel `tfsdk:"condition_group"`
+}
+
+func (m *DetectorResourceModel) Fill(organization string, project string, detector sentryclient.Detector) error {
+	m.Id = types.StringPointerValue(detector.ID)
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Name = types.StringValue(detector.Name)
+	m.Enabled = types.BoolValue(detector.Enabled)
+	m.DetectionType = types.StringValue(detector.Config.DetectionType)
+	m.ComparisonDelta = types.Int64PointerValue(detector.Config.ComparisonDelta)
+
+	m.Owner = types.StringNull()
+	if detector.Owner != nil {
+		m.Owner = types.StringValue(string(*detector.Owner))
+	}
+
+	if len(detector.DataSources) > 0 {
+		query := detector.DataSources[0].QueryObj.SnubaQuery
+		m.DataSource = &DetectorDataSourceModel{
+			Query:       types.StringValue(query.Query),
+			Aggregate:   types.StringValue(query.Aggregate),
+			TimeWindow:  types.Int64Value(query.TimeWindow),
+			Environment: types.StringPointerValue(query.Environment),
+			Dataset:     types.StringValue(query.Dataset),
+			EventTypes:  types.SetNull(types.StringType),
+		}
+		if len(query.EventTypes) > 0 {
+			eventTypeElements := []attr.Value{}
+			for _, eventType := range query.EventTypes {
+				eventTypeElements = append(eventTypeElements, types.StringValue(eventType))
+			}
+			m.DataSource.EventTypes = types.SetValueMust(types.StringType, eventTypeElements)
+		}
+	}
+
+	m.ConditionGroup = nil
+	if detector.ConditionGroup != nil {
+		m.ConditionGroup = &DataConditionGroupModel{}
+		if err := m.ConditionGroup.Fill(*detector.ConditionGroup); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DetectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_detector"
+}
+
+func (r *DetectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Detector resource, also known as a monitor. A metric detector creates issues when a metric crosses the thresholds of its conditions. Detectors replace metric alerts; connect them to a `sentry_workflow` to perform actions.\n\n" +
+			"An existing `sentry_metric_alert` can be moved to a `sentry_detector` with a `moved` block (Terraform 1.8 or later) instead of being recreated. The state is converted and the ID of the detector Sentry migrated the metric alert to is looked up on the next refresh. Trigger actions are not part of the detector; Sentry moves them to a separate workflow.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the resource belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project the resource belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the detector.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the detector is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The team or user that owns the detector. Either `team:<id>`, `team:<slug>`, `user:<id>`, or `user:<email>`. Slugs and emails are resolved to IDs and kept in the configured form.",
+				Optional:            true,
+			},
+			"detection_type": schema.StringAttribute{
+				MarkdownDescription: "How the thresholds are evaluated. `static` compares the metric against the thresholds, `percent` compares the change relative to `comparison_delta` seconds ago, and `dynamic` uses anomaly detection. Defaults to `static`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("static"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("static", "percent", "dynamic"),
+				},
+			},
+			"comparison_delta": schema.Int64Attribute{
+				MarkdownDescription: "The period in seconds to compare against when `detection_type` is `percent`.",
+				Optional:            true,
+			},
+			"data_source": schema.SingleNestedAttribute{
+				MarkdownDescription: "The metric query evaluated by the detector.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"query": schema.StringAttribute{
+						MarkdownDescription: "The query filtering the events, e.g. `transaction:/api/*`. Use an empty string to include all events.",
+						Required:            true,
+					},
+					"aggregate": schema.StringAttribute{
+						MarkdownDescription: "The aggregate function, e.g. `count()` or `p95(transaction.duration)`.",
+						Required:            true,
+					},
+					"time_window": schema.Int64Attribute{
+						MarkdownDescription: "The period in seconds the aggregate is evaluated over.",
+						Required:            true,
+					},
+					"environment": schema.StringAttribute{
+						MarkdownDescription: "Only evaluate events in this environment.",
+						Optional:            true,
+					},
+					"dataset": schema.StringAttribute{
+						MarkdownDescription: "The dataset the query runs against, e.g. `events` or `generic_metrics`.",
+						Required:            true,
+					},
+					"event_types": schema.SetAttribute{
+						MarkdownDescription: "The event types the query runs against, e.g. `error` or `transaction`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+			"condition_group": schema.SingleNestedAttribute{
+				MarkdownDescription: "The threshold conditions of the detector. Each condition compares the metric using `gt`, `gte`, `lt`, or `lte`, and results in a priority level: `75` (high), `50` (medium), `25` (low), or `0` to resolve the issue.",
+				Required:            true,
+				Attributes:          dataConditionGroupAttributes(),
+			},
+		},
+	}
+}
+
+func (r *DetectorResource) params(ctx context.Context, data DetectorResourceModel) (*sentryclient.DetectorParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	project, _, err := r.client.Projects.Get(ctx, data.Organization.ValueString(), data.Project.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading project: %s", err.Error()))
+		return nil, diags
+	}
+
+	params := &sentryclient.DetectorParams{
+		ProjectID: project.ID,
+		Name:      data.Name.ValueString(),
+		Type:      detectorTypeMetricIssue,
+		Enabled:   data.Enabled.ValueBool(),
+		Config: sentryclient.DetectorConfig{
+			DetectionType:   data.DetectionType.ValueString(),
+			ComparisonDelta: data.ComparisonDelta.ValueInt64Pointer(),
+		},
+		DataSource: sentryclient.DetectorSnubaQuery{
+			Query:       data.DataSource.Query.ValueString(),
+			Aggregate:   data.DataSource.Aggregate.ValueString(),
+			TimeWindow:  data.DataSource.TimeWindow.ValueInt64(),
+			Environment: data.DataSource.Environment.ValueStringPointer(),
+			Dataset:     data.DataSource.Dataset.ValueString(),
+		},
+	}
+
+	if !data.DataSource.EventTypes.IsNull() {
+		diags.Append(data.DataSource.EventTypes.ElementsAs(ctx, &params.DataSource.EventTypes, false)...)
+	}
+
+	if !data.Owner.IsNull() {
+		owner, err := sentryclient.ResolveOwner(ctx, r.client, data.Organization.ValueString(), data.Owner.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("owner"), "Invalid Owner", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+			return nil, diags
+		}
+		params.Owner = &owner
+	}
+
+	conditionGroup, conditionGroupDiags := data.ConditionGroup.ToAPI()
+	diags.Append(conditionGroupDiags...)
+	params.ConditionGroup = conditionGroup
+
+	return params, diags
+}
+
+// fill fills the model from the detector, keeping the configured project slug
+// and owner form as long as they still refer to the same project and owner.
+func (r *DetectorResource) fill(ctx context.Context, data *DetectorResourceModel, detector sentryclient.Detector) error {
+	projectSlug := data.Project.ValueString()
+	project, apiResp, err := r.client.Projects.Get(ctx, data.Organization.ValueString(), projectSlug)
+	if (apiResp != nil && apiResp.StatusCode == http.StatusNotFound) || (err == nil && project.ID != detector.ProjectID) {
+		projectMap, err := sentryclient.GetProjectIdToSlugMap(ctx, r.client)
+		if err != nil {
+			return err
+		}
+		projectSlug = projectMap[detector.ProjectID]
+	} else if err != nil {
+		return err
+	}
+
+	configuredOwner := data.Owner
+	if err := data.Fill(data.Organization.ValueString(), projectSlug, detector); err != nil {
+		return err
+	}
+
+	if !configuredOwner.IsNull() && !data.Owner.IsNull() {
+		equal, err := sentryclient.OwnerEquals(ctx, r.client, data.Organization.ValueString(), configuredOwner.ValueString(), data.Owner.ValueString())
+		if err != nil {
+			return err
+		}
+		if equal {
+			data.Owner = configuredOwner
+		}
+	}
+
+	return nil
+}
+
+func (r *DetectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DetectorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := r.params(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detector, _, err := sentryclient.CreateDetector(ctx, r.client, data.Organization.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating detector: %s", err.Error()))
+		return
+	}
+
+	if err := r.fill(ctx, &data, *detector); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling detector: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DetectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DetectorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	movedAlertId, diags := movedAlertIdFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if movedAlertId != "" {
+		detectorId, _, err := sentryclient.GetDetectorIDForMetricAlert(ctx, r.client, data.Organization.ValueString(), movedAlertId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error looking up the detector of metric alert %s: %s", movedAlertId, err.Error()))
+			return
+		}
+		data.Id = types.StringValue(detectorId)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyMovedAlertId, nil)...)
+	}
+
+	detector, apiResp, err := sentryclient.GetDetector(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Detector not found: %s", err.Error()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading detector: %s", err.Error()))
+		return
+	}
+
+	if err := r.fill(ctx, &data, *detector); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling detector: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DetectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DetectorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := r.params(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detector, apiResp, err := sentryclient.UpdateDetector(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString(), params)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Detector not found: %s", err.Error()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating detector: %s", err.Error()))
+		return
+	}
+
+	if err := r.fill(ctx, &data, *detector); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling detector: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DetectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DetectorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := sentryclient.DeleteDetector(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting detector: %s", err.Error()))
+		return
+	}
+}
+
+func (r *DetectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, detectorId, err := splitThreePartID(req.ID, "organization", "project-slug", "detector-id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), detectorId,
+	)...)
+}
+
+func (r *DetectorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "sentry_metric_alert" || !isSentryProviderAddress(req.SourceProviderAddress) {
+					return
+				}
+
+				// The metric alert is a plugin SDK resource, so its state is
+				// decoded from the raw JSON.
+				var source metricAlertState
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError("Invalid Source State", fmt.Sprintf("Error decoding sentry_metric_alert state: %s", err.Error()))
+					return
+				}
+
+				data, err := metricAlertToDetector(source)
+				if err != nil {
+					resp.Diagnostics.AddError("Conversion Error", err.Error())
+					return
+				}
+
+				alertId := source.InternalId
+				if alertId == "" {
+					_, _, alertId, err = splitSentryAlertID(source.Id)
+					if err != nil {
+						resp.Diagnostics.AddError("Invalid Source State", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+						return
+					}
+				}
+
+				// The provider is not configured while moving state, so the ID of
+				// the detector is looked up on the next read.
+				resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, privateKeyMovedAlertId, must.Get(json.Marshal(alertId)))...)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccDetectorResource(t *testing.T) {
+	rn := "sentry_detector.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+	detector := acctest.RandomWithPrefix("tf-detector")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorResourceConfig(team, project, detector, 100),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(detector)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("owner"), knownvalue.StringExact("team:"+team)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("detection_type"), knownvalue.StringExact("static")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("data_source").AtMapKey("time_window"), knownvalue.Int64Exact(3600)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("condition_group").AtMapKey("conditions"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("condition_group").AtMapKey("conditions").AtSliceIndex(0).AtMapKey("comparison"), knownvalue.StringExact("100")),
+				},
+			},
+			{
+				Config: testAccDetectorResourceConfig(team, project, detector+"-renamed", 200),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(detector+"-renamed")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("condition_group").AtMapKey("conditions").AtSliceIndex(0).AtMapKey("comparison"), knownvalue.StringExact("200")),
+				},
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", rn)
+					}
+					return buildThreePartID(rs.Primary.Attributes["organization"], rs.Primary.Attributes["project"], rs.Primary.ID), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"owner"},
+			},
+		},
+	})
+}
+
+func testAccDetectorResourceConfig(teamName string, projectName string, detectorName string, threshold int) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.id
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	teams        = [sentry_team.test.id]
+	name         = "%[2]s"
+	platform     = "go"
+}
+
+resource "sentry_detector" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "%[3]s"
+	owner        = "team:${sentry_team.test.slug}"
+
+	data_source = {
+		query       = ""
+		aggregate   = "count()"
+		time_window = 3600
+		dataset     = "events"
+		event_types = ["error"]
+	}
+
+	condition_group = {
+		logic_type = "any"
+		conditions = [
+			{
+				type             = "gt"
+				comparison       = jsonencode(%[4]d)
+				condition_result = jsonencode(75)
+			},
+			{
+				type             = "lte"
+				comparison       = jsonencode(%[4]d)
+				condition_result = jsonencode(0)
+			},
+		]
+	}
+}
+`, teamName, projectName, detectorName, threshold)
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ExternalTeamResource{}
+var _ resource.ResourceWithConfigure = &ExternalTeamResource{}
+var _ resource.ResourceWithImportState = &ExternalTeamResource{}
+
+func NewExternalTeamResource() resource.Resource {
+	return &ExternalTeamResource{}
+}
+
+type ExternalTeamResource struct {
+	baseResource
+}
+
+type ExternalTeamResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Organization  types.String `tfsdk:"organization"`
+	Team          types.String `tfsdk:"team"`
+	Provider      types.String `tfsdk:"provider_key"`
+	ExternalName  types.String `tfsdk:"external_name"`
+	ExternalId    types.String `tfsdk:"external_id"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+}
+
+func (m *ExternalTeamResourceModel) Fill(organization string, team string, externalTeam sentryclient.ExternalActor) error {
+	m.Id = types.StringValue(externalTeam.ID)
+	m.Organization = types.StringValue(organization)
+	m.Team = types.StringValue(team)
+	m.Provider = types.StringValue(externalTeam.Provider)
+	m.ExternalName = types.StringValue(externalTeam.ExternalName)
+	m.ExternalId = types.StringPointerValue(externalTeam.ExternalID)
+	m.IntegrationId = types.StringValue(externalTeam.IntegrationID)
+
+	return nil
+}
+
+func (r *ExternalTeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_team"
+}
+
+func (r *ExternalTeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry External Team resource. Links a team of an external provider, such as a GitHub team or a Slack channel, to a team of the organization. Sentry uses these links to resolve CODEOWNERS files and to send team notifications to chat.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the team belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "The slug of the team to link the external team to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_key": schema.StringAttribute{
+				MarkdownDescription: "The provider of the external team. One of `" + strings.Join(externalActorProviders, "`, `") + "`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(externalActorProviders...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the team in the provider, e.g. `@my-org/payments` for a GitHub team or `#payments` for a Slack channel.",
+				Required:            true,
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team in the provider, e.g. the Slack channel ID.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization integration of the provider, see the `sentry_organization_integration` data source.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ExternalTeamResource) params(ctx context.Context, data ExternalTeamResourceModel) (*sentryclient.ExternalTeamParams, error) {
+	team, _, err := r.client.Teams.Get(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read team: %w", err)
+	}
+
+	params := &sentryclient.ExternalTeamParams{
+		TeamID:        sentry.StringValue(team.ID),
+		Provider:      data.Provider.ValueString(),
+		ExternalName:  data.ExternalName.ValueString(),
+		IntegrationID: data.IntegrationId.ValueString(),
+	}
+	if !data.ExternalId.IsUnknown() {
+		params.ExternalID = data.ExternalId.ValueStringPointer()
+	}
+	return params, nil
+}
+
+func (r *ExternalTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := r.params(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating external team: %s", err.Error()))
+		return
+	}
+
+	tflog.Debug(ctx, "Creating external team", map[string]interface{}{
+		"org":          data.Organization.ValueString(),
+		"team":         data.Team.ValueString(),
+		"provider":     params.Provider,
+		"externalName": params.ExternalName,
+	})
+	externalTeam, _, err := sentryclient.CreateExternalTeam(ctx, r.client, data.Organization.ValueString(), data.Team.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating external team: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Team.ValueString(), *externalTeam); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalTeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalTeams, apiResp, err := sentryclient.ListTeamExternalTeams(ctx, r.client, data.Organization.ValueString(), data.Team.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Team not found: %s", data.Team.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading external team: %s", err.Error()))
+		return
+	}
+
+	var externalTeam *sentryclient.ExternalActor
+	for i := range externalTeams {
+		if externalTeams[i].ID == data.Id.ValueString() {
+			externalTeam = &externalTeams[i]
+			break
+		}
+	}
+	if externalTeam == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("External team not found: %s", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Team.ValueString(), *externalTeam); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalTeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := r.params(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating external team: %s", err.Error()))
+		return
+	}
+
+	externalTeam, _, err := sentryclient.UpdateExternalTeam(ctx, r.client, data.Organization.ValueString(), data.Team.ValueString(), data.Id.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating external team: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Team.ValueString(), *externalTeam); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalTeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := sentryclient.DeleteExternalTeam(ctx, r.client, data.Organization.ValueString(), data.Team.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting external team: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ExternalTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, team, id, err := splitThreePartID(req.ID, "organization", "team-slug", "id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("team"), team,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), id,
+	)...)
+}
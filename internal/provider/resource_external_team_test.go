@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccExternalTeamResource(t *testing.T) {
+	rn := "sentry_external_team.test"
+	team := acctest.RandomWithPrefix("tf-team")
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("team"), knownvalue.StringExact(team)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("provider_key"), knownvalue.StringExact("github")),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_id"), knownvalue.NotNull()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExternalTeamResourceConfig(team, "@jianyuan/"+team),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact("@jianyuan/"+team)),
+				),
+			},
+			{
+				Config: testAccExternalTeamResourceConfig(team, "@jianyuan/"+team+"-renamed"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact("@jianyuan/"+team+"-renamed")),
+				),
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", rn)
+					}
+					organization := rs.Primary.Attributes["organization"]
+					team := rs.Primary.Attributes["team"]
+					return buildThreePartID(organization, team, rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccExternalTeamResourceConfig(teamName string, externalName string) string {
+	return testAccTeamResourceConfig(teamName) + fmt.Sprintf(`
+data "sentry_organization_integration" "test" {
+	organization = data.sentry_organization.test.id
+	provider_key = "github"
+	name         = "jianyuan"
+}
+
+resource "sentry_external_team" "test" {
+	organization   = sentry_team.test.organization
+	team           = sentry_team.test.slug
+	provider_key   = "github"
+	external_name  = "%[1]s"
+	integration_id = data.sentry_organization_integration.test.id
+}
+`, externalName)
+}
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ExternalUserResource{}
+var _ resource.ResourceWithConfigure = &ExternalUserResource{}
+var _ resource.ResourceWithImportState = &ExternalUserResource{}
+
+// externalActorProviders are the providers whose users and teams can be linked
+// to Sentry users and teams.
+var externalActorProviders = []string{"github", "gitlab", "slack", "msteams"}
+
+func NewExternalUserResource() resource.Resource {
+	return &ExternalUserResource{}
+}
+
+type ExternalUserResource struct {
+	baseResource
+}
+
+type ExternalUserResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Organization  types.String `tfsdk:"organization"`
+	MemberId      types.String `tfsdk:"member_id"`
+	Provider      types.String `tfsdk:"provider_key"`
+	ExternalName  types.String `tfsdk:"external_name"`
+	ExternalId    types.String `tfsdk:"external_id"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+}
+
+func (m *ExternalUserResourceModel) Fill(organization string, memberId string, externalUser sentryclient.ExternalActor) error {
+	m.Id = types.StringValue(externalUser.ID)
+	m.Organization = types.StringValue(organization)
+	m.MemberId = types.StringValue(memberId)
+	m.Provider = types.StringValue(externalUser.Provider)
+	m.ExternalName = types.StringValue(externalUser.ExternalName)
+	m.ExternalId = types.StringPointerValue(externalUser.ExternalID)
+	m.IntegrationId = types.StringValue(externalUser.IntegrationID)
+
+	return nil
+}
+
+func (r *ExternalUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_user"
+}
+
+func (r *ExternalUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry External User resource. Links a user of an external provider, such as a GitHub username or a Slack user, to a member of the organization. Sentry uses these links to resolve CODEOWNERS files and to notify users in chat.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the member belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization member to link the external user to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_key": schema.StringAttribute{
+				MarkdownDescription: "The provider of the external user. One of `" + strings.Join(externalActorProviders, "`, `") + "`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(externalActorProviders...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user in the provider, e.g. `@jane` for a GitHub or Slack user.",
+				Required:            true,
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user in the provider, e.g. the Slack user ID.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization integration of the provider, see the `sentry_organization_integration` data source.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ExternalUserResource) params(ctx context.Context, data ExternalUserResourceModel) (*sentryclient.ExternalUserParams, error) {
+	member, _, err := r.client.OrganizationMembers.Get(ctx, data.Organization.ValueString(), data.MemberId.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read organization member: %w", err)
+	}
+
+	params := &sentryclient.ExternalUserParams{
+		UserID:        member.User.ID,
+		Provider:      data.Provider.ValueString(),
+		ExternalName:  data.ExternalName.ValueString(),
+		IntegrationID: data.IntegrationId.ValueString(),
+	}
+	if !data.ExternalId.IsUnknown() {
+		params.ExternalID = data.ExternalId.ValueStringPointer()
+	}
+	return params, nil
+}
+
+func (r *ExternalUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := r.params(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating external user: %s", err.Error()))
+		return
+	}
+
+	tflog.Debug(ctx, "Creating external user", map[string]interface{}{
+		"org":          data.Organization.ValueString(),
+		"memberId":     data.MemberId.ValueString(),
+		"provider":     params.Provider,
+		"externalName": params.ExternalName,
+	})
+	externalUser, _, err := sentryclient.CreateExternalUser(ctx, r.client, data.Organization.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating external user: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.MemberId.ValueString(), *externalUser); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalUsers, apiResp, err := sentryclient.ListMemberExternalUsers(ctx, r.client, data.Organization.ValueString(), data.MemberId.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Organization member not found: %s", data.MemberId.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading external user: %s", err.Error()))
+		return
+	}
+
+	var externalUser *sentryclient.ExternalActor
+	for i := range externalUsers {
+		if externalUsers[i].ID == data.Id.ValueString() {
+			externalUser = &externalUsers[i]
+			break
+		}
+	}
+	if externalUser == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("External user not found: %s", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.MemberId.ValueString(), *externalUser); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := r.params(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating external user: %s", err.Error()))
+		return
+	}
+
+	externalUser, _, err := sentryclient.UpdateExternalUser(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating external user: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.MemberId.ValueString(), *externalUser); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := sentryclient.DeleteExternalUser(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting external user: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ExternalUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, memberId, id, err := splitThreePartID(req.ID, "organization", "member-id", "id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("member_id"), memberId,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), id,
+	)...)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccExternalUserResource(t *testing.T) {
+	acctest.PreCheck(t)
+
+	// External users can only be linked to members that accepted their invite.
+	members, _, err := acctest.SharedClient.OrganizationMembers.List(context.Background(), acctest.TestOrganization, &sentry.ListCursorParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var memberId string
+	for _, member := range members {
+		if !member.Pending {
+			memberId = member.ID
+			break
+		}
+	}
+	if memberId == "" {
+		t.Skip("no active member in the test organization")
+	}
+
+	rn := "sentry_external_user.test"
+	externalName := acctest.RandomWithPrefix("@tf-user")
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("member_id"), knownvalue.StringExact(memberId)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("provider_key"), knownvalue.StringExact("github")),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_id"), knownvalue.NotNull()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExternalUserResourceConfig(memberId, externalName),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact(externalName)),
+				),
+			},
+			{
+				Config: testAccExternalUserResourceConfig(memberId, externalName+"-renamed"),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact(externalName+"-renamed")),
+				),
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", rn)
+					}
+					organization := rs.Primary.Attributes["organization"]
+					memberId := rs.Primary.Attributes["member_id"]
+					return buildThreePartID(organization, memberId, rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccExternalUserResourceConfig(memberId string, externalName string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+data "sentry_organization_integration" "test" {
+	organization = data.sentry_organization.test.id
+	provider_key = "github"
+	name         = "jianyuan"
+}
+
+resource "sentry_external_user" "test" {
+	organization   = data.sentry_organization.test.id
+	member_id      = "%[1]s"
+	provider_key   = "github"
+	external_name  = "%[2]s"
+	integration_id = data.sentry_organization_integration.test.id
+}
+`, memberId, externalName)
+}
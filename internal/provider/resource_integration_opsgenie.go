@@ -12,11 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
 )
 
 var _ resource.Resource = &IntegrationOpsgenie{}
 var _ resource.ResourceWithConfigure = &IntegrationOpsgenie{}
 var _ resource.ResourceWithImportState = &IntegrationOpsgenie{}
+var _ resource.ResourceWithModifyPlan = &IntegrationOpsgenie{}
 
 func NewIntegrationOpsgenie() resource.Resource {
 	return &IntegrationOpsgenie{}
@@ -44,6 +47,12 @@ func (m *IntegrationOpsgenieModel) Fill(organization string, integrationId strin
 	return nil
 }
 
+func (m IntegrationOpsgenieModel) secrets() map[string]types.String {
+	return map[string]types.String{
+		"integration_key": m.IntegrationKey,
+	}
+}
+
 type IntegrationOpsgenieConfigDataTeamTableItem struct {
 	Team           string `json:"team"`
 	IntegrationKey string `json:"integration_key"`
@@ -90,6 +99,27 @@ func (r *IntegrationOpsgenie) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// ModifyPlan warns when the integration key differs from the one last applied.
+func (r *IntegrationOpsgenie) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data IntegrationOpsgenieModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, diags := secretHashesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(secretRotationWarnings(hashes, data.secrets())...)
+}
+
 func (r *IntegrationOpsgenie) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data IntegrationOpsgenieModel
 
@@ -178,7 +208,14 @@ func (r *IntegrationOpsgenie) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	hashes, err := secretHashes{}.update(data.secrets())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error hashing secrets: %s", err.Error()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeySecretHashes, must.Get(json.Marshal(hashes)))...)
 }
 
 func (r *IntegrationOpsgenie) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -319,7 +356,20 @@ func (r *IntegrationOpsgenie) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	priorHashes, diags := secretHashesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, err := priorHashes.update(data.secrets())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error hashing secrets: %s", err.Error()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeySecretHashes, must.Get(json.Marshal(hashes)))...)
 }
 
 func (r *IntegrationOpsgenie) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
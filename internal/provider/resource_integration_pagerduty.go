@@ -12,11 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
 )
 
 var _ resource.Resource = &IntegrationPagerDuty{}
 var _ resource.ResourceWithConfigure = &IntegrationPagerDuty{}
 var _ resource.ResourceWithImportState = &IntegrationPagerDuty{}
+var _ resource.ResourceWithModifyPlan = &IntegrationPagerDuty{}
 
 func NewIntegrationPagerDuty() resource.Resource {
 	return &IntegrationPagerDuty{}
@@ -44,6 +47,12 @@ func (m *IntegrationPagerDutyModel) Fill(organization string, integrationId stri
 	return nil
 }
 
+func (m IntegrationPagerDutyModel) secrets() map[string]types.String {
+	return map[string]types.String{
+		"integration_key": m.IntegrationKey,
+	}
+}
+
 type IntegrationPagerDutyConfigDataServiceTableItem struct {
 	Service        string      `json:"service"`
 	IntegrationKey string      `json:"integration_key"`
@@ -90,6 +99,27 @@ func (r *IntegrationPagerDuty) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+// ModifyPlan warns when the integration key differs from the one last applied.
+func (r *IntegrationPagerDuty) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data IntegrationPagerDutyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, diags := secretHashesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(secretRotationWarnings(hashes, data.secrets())...)
+}
+
 func (r *IntegrationPagerDuty) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data IntegrationPagerDutyModel
 
@@ -178,7 +208,14 @@ func (r *IntegrationPagerDuty) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	hashes, err := secretHashes{}.update(data.secrets())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error hashing secrets: %s", err.Error()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeySecretHashes, must.Get(json.Marshal(hashes)))...)
 }
 
 func (r *IntegrationPagerDuty) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -319,7 +356,20 @@ func (r *IntegrationPagerDuty) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	priorHashes, diags := secretHashesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, err := priorHashes.update(data.secrets())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error hashing secrets: %s", err.Error()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeySecretHashes, must.Get(json.Marshal(hashes)))...)
 }
 
 func (r *IntegrationPagerDuty) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
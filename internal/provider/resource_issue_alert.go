@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
 	"github.com/canva/terraform-provider-sentry/internal/sentrytypes"
 
 	"github.com/jianyuan/go-sentry/v2/sentry"
@@ -24,6 +26,7 @@ import (
 var _ resource.Resource = &IssueAlertResource{}
 var _ resource.ResourceWithConfigure = &IssueAlertResource{}
 var _ resource.ResourceWithImportState = &IssueAlertResource{}
+var _ resource.ResourceWithModifyPlan = &IssueAlertResource{}
 var _ resource.ResourceWithUpgradeState = &IssueAlertResource{}
 
 func NewIssueAlertResource() resource.Resource {
@@ -174,13 +177,50 @@ Please note the following changes since v0.12.0:
 				Optional:            true,
 			},
 			"owner": schema.StringAttribute{
-				MarkdownDescription: "The ID of the team or user that owns the rule.",
+				MarkdownDescription: "The team or user that owns the rule. Either `team:<id>`, `team:<slug>`, `user:<id>`, or `user:<email>`. Slugs and emails are resolved to IDs and kept in the configured form.",
 				Optional:            true,
 			},
 		},
 	}
 }
 
+// ModifyPlan warns about environments that have not been seen in the project,
+// which are usually typos.
+func (r *IssueAlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var organization, project, environment types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project"), &project)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environment"), &environment)...)
+	if resp.Diagnostics.HasError() || organization.IsUnknown() || project.IsUnknown() || environment.IsUnknown() || environment.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var priorEnvironment types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment"), &priorEnvironment)...)
+		if priorEnvironment.Equal(environment) {
+			return
+		}
+	}
+
+	warning, err := sentryclient.UnknownEnvironmentWarning(ctx, r.client, organization.ValueString(), project.ValueString(), environment.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("environment"),
+			"Unable to Validate Environment",
+			fmt.Sprintf("The environments of project %s could not be listed, so %q was not checked: %s", project.ValueString(), environment.ValueString(), err.Error()),
+		)
+		return
+	}
+	if warning != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("environment"), "Unknown Environment", warning)
+	}
+}
+
 func (r *IssueAlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data IssueAlertResourceModel
 
@@ -190,12 +230,18 @@ func (r *IssueAlertResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	owner, err := r.resolveOwner(ctx, data.Organization.ValueString(), data.Owner)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("owner"), "Invalid Owner", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+		return
+	}
+
 	params := &sentry.IssueAlert{
 		Name:        data.Name.ValueStringPointer(),
 		ActionMatch: data.ActionMatch.ValueStringPointer(),
 		FilterMatch: data.FilterMatch.ValueStringPointer(),
 		Frequency:   sentry.JsonNumber(json.Number(data.Frequency.String())),
-		Owner:       data.Owner.ValueStringPointer(),
+		Owner:       owner,
 		Environment: data.Environment.ValueStringPointer(),
 		Projects:    []string{data.Project.String()},
 	}
@@ -224,10 +270,15 @@ func (r *IssueAlertResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	configuredOwner := data.Owner
 	if err := data.Fill(data.Organization.ValueString(), *action); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling issue alert: %s", err.Error()))
 		return
 	}
+	if err := r.preserveOwner(ctx, data.Organization.ValueString(), configuredOwner, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+		return
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -261,10 +312,15 @@ func (r *IssueAlertResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	configuredOwner := data.Owner
 	if err := data.Fill(data.Organization.ValueString(), *action); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling issue alert: %s", err.Error()))
 		return
 	}
+	if err := r.preserveOwner(ctx, data.Organization.ValueString(), configuredOwner, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -278,12 +334,18 @@ func (r *IssueAlertResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	owner, err := r.resolveOwner(ctx, data.Organization.ValueString(), data.Owner)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("owner"), "Invalid Owner", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+		return
+	}
+
 	params := &sentry.IssueAlert{
 		Name:        data.Name.ValueStringPointer(),
 		ActionMatch: data.ActionMatch.ValueStringPointer(),
 		FilterMatch: data.FilterMatch.ValueStringPointer(),
 		Frequency:   sentry.JsonNumber(json.Number(data.Frequency.String())),
-		Owner:       data.Owner.ValueStringPointer(),
+		Owner:       owner,
 		Environment: data.Environment.ValueStringPointer(),
 		Projects:    []string{data.Project.String()},
 	}
@@ -318,10 +380,15 @@ func (r *IssueAlertResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	configuredOwner := data.Owner
 	if err := data.Fill(data.Organization.ValueString(), *action); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling issue alert: %s", err.Error()))
 		return
 	}
+	if err := r.preserveOwner(ctx, data.Organization.ValueString(), configuredOwner, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error resolving owner: %s", err.Error()))
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -351,12 +418,51 @@ func (r *IssueAlertResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// resolveOwner converts the configured owner into the ID form accepted by the
+// Sentry API.
+func (r *IssueAlertResource) resolveOwner(ctx context.Context, organization string, owner types.String) (*string, error) {
+	if owner.IsNull() || owner.IsUnknown() {
+		return nil, nil
+	}
+
+	resolved, err := sentryclient.ResolveOwner(ctx, r.client, organization, owner.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return &resolved, nil
+}
+
+// preserveOwner keeps the owner in the form it was configured, e.g.
+// `team:<slug>`, as long as it still refers to the owner returned by Sentry.
+func (r *IssueAlertResource) preserveOwner(ctx context.Context, organization string, configured types.String, data *IssueAlertResourceModel) error {
+	if configured.IsNull() || configured.IsUnknown() || data.Owner.IsNull() {
+		return nil
+	}
+
+	equal, err := sentryclient.OwnerEquals(ctx, r.client, organization, configured.ValueString(), data.Owner.ValueString())
+	if err != nil {
+		return err
+	}
+	if equal {
+		data.Owner = configured
+	}
+	return nil
+}
+
 func (r *IssueAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	organization, project, actionId, err := splitThreePartID(req.ID, "organization", "project-slug", "alert-id")
+	organization, project, actionId, err := splitSentryAlertImportID(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
 		return
 	}
+	if name, ok := strings.CutPrefix(actionId, sentryclient.AlertNamePrefix); ok {
+		alert, err := sentryclient.FindIssueAlertByName(ctx, r.client, organization, project, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error finding issue alert by name: %s", err.Error()))
+			return
+		}
+		actionId = sentry.StringValue(alert.ID)
+	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(
 		ctx, path.Root("organization"), organization,
 	)...)
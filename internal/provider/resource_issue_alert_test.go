@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -68,6 +69,13 @@ func TestAccIssueAlertResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"conditions", "filters", "actions"},
 			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateId:           buildThreePartID(acctest.TestOrganization, project, "name:"+alert+"-updated"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"conditions", "filters", "actions"},
+			},
 		},
 	})
 }
@@ -260,6 +268,36 @@ func TestAccIssueAlertResource_EmptyArray(t *testing.T) {
 	})
 }
 
+func TestAccIssueAlertResource_OwnerSlug(t *testing.T) {
+	rn := "sentry_issue_alert.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+	alert := acctest.RandomWithPrefix("tf-issue-alert")
+	var alertId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIssueAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueAlertConfigOwner(team, project, alert, `"team:${sentry_team.test.slug}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIssueAlertExists(rn, &alertId),
+					resource.TestCheckResourceAttr(rn, "owner", "team:"+team),
+				),
+			},
+			{
+				Config: testAccIssueAlertConfigOwner(team, project, alert, `"team:${sentry_team.test.team_id}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIssueAlertExists(rn, &alertId),
+					resource.TestMatchResourceAttr(rn, "owner", regexp.MustCompile(`^team:\d+$`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIssueAlertDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "sentry_issue_alert" {
@@ -349,6 +387,45 @@ EOT
 `, teamName, projectName, alertName)
 }
 
+func testAccIssueAlertConfigOwner(teamName string, projectName string, alertName string, owner string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.id
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	teams        = [sentry_team.test.id]
+	name         = "%[2]s"
+	platform     = "go"
+}
+
+resource "sentry_issue_alert" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "%[3]s"
+	owner        = %[4]s
+
+	action_match = "any"
+	filter_match = "any"
+	frequency    = 30
+
+	conditions = "[]"
+
+	actions = <<EOT
+[
+	{
+		"id": "sentry.mail.actions.NotifyEmailAction",
+		"targetType": "IssueOwners"
+	}
+]
+EOT
+}
+`, teamName, projectName, alertName, owner)
+}
+
 func testAccIssueAlertConfig(teamName string, projectName string, alertName string) string {
 	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
 resource "sentry_team" "test" {
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &OrganizationDataScrubbingResource{}
+var _ resource.ResourceWithConfigure = &OrganizationDataScrubbingResource{}
+var _ resource.ResourceWithImportState = &OrganizationDataScrubbingResource{}
+var _ resource.ResourceWithValidateConfig = &OrganizationDataScrubbingResource{}
+
+func NewOrganizationDataScrubbingResource() resource.Resource {
+	return &OrganizationDataScrubbingResource{}
+}
+
+type OrganizationDataScrubbingResource struct {
+	baseResource
+}
+
+type OrganizationDataScrubbingResourceModel struct {
+	Id           types.String             `tfsdk:"id"`
+	Organization types.String             `tfsdk:"organization"`
+	Rules        []DataScrubbingRuleModel `tfsdk:"rules"`
+}
+
+func (m *OrganizationDataScrubbingResourceModel) Fill(organization string, relayPiiConfig *string) error {
+	m.Id = types.StringValue(organization)
+	m.Organization = types.StringValue(organization)
+
+	rules, err := parseRelayPiiConfig(sentry.StringValue(relayPiiConfig))
+	if err != nil {
+		return err
+	}
+	m.Rules = rules
+
+	return nil
+}
+
+func (r *OrganizationDataScrubbingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_data_scrubbing"
+}
+
+func (r *OrganizationDataScrubbingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Organization Data Scrubbing resource. Manages the advanced data scrubbing rules that Relay applies to the events of every project of an organization, in addition to the rules of each project.\n\n" +
+			"This resource is authoritative for the rule types it supports: it replaces any such rules added in the Sentry UI, and destroying it removes them. Rules of other types, e.g. `redact_pair`, are left unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": dataScrubbingRulesAttribute(),
+		},
+	}
+}
+
+func (r *OrganizationDataScrubbingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateDataScrubbingRules(ctx, req.Config)...)
+}
+
+func (r *OrganizationDataScrubbingResource) update(ctx context.Context, data *OrganizationDataScrubbingResourceModel) error {
+	current, _, err := r.client.Organizations.Get(ctx, data.Organization.ValueString())
+	if err != nil {
+		return err
+	}
+
+	relayPiiConfig, err := buildRelayPiiConfig(data.Rules, sentry.StringValue(current.RelayPiiConfig))
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "Updating organization data scrubbing rules", map[string]interface{}{
+		"org":   data.Organization.ValueString(),
+		"rules": len(data.Rules),
+	})
+	org, _, err := sentryclient.UpdateOrganizationRelayPiiConfig(ctx, r.client, data.Organization.ValueString(), relayPiiConfig)
+	if err != nil {
+		return err
+	}
+
+	return data.Fill(data.Organization.ValueString(), org.RelayPiiConfig)
+}
+
+func (r *OrganizationDataScrubbingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating organization data scrubbing rules: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationDataScrubbingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org, apiResp, err := r.client.Organizations.Get(ctx, data.Organization.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Organization not found: %s", data.Organization.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading organization: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(unsupportedRelayPiiRuleWarnings(sentry.StringValue(org.RelayPiiConfig))...)
+	if err := data.Fill(data.Organization.ValueString(), org.RelayPiiConfig); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationDataScrubbingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating organization data scrubbing rules: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationDataScrubbingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, apiResp, err := r.client.Organizations.Get(ctx, data.Organization.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading organization: %s", err.Error()))
+		return
+	}
+
+	relayPiiConfig, err := buildRelayPiiConfig(nil, sentry.StringValue(current.RelayPiiConfig))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing organization data scrubbing rules: %s", err.Error()))
+		return
+	}
+
+	_, apiResp, err = sentryclient.UpdateOrganizationRelayPiiConfig(ctx, r.client, data.Organization.ValueString(), relayPiiConfig)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing organization data scrubbing rules: %s", err.Error()))
+		return
+	}
+}
+
+func (r *OrganizationDataScrubbingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), req.ID,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
@@ -0,0 +1,1018 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithConfigure = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
+var _ resource.ResourceWithUpgradeState = &ProjectResource{}
+
+// errProjectNotFound is returned when neither the slug nor the internal ID of
+// a project resolve to a project.
+var errProjectNotFound = errors.New("project not found")
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+type ProjectResource struct {
+	baseResource
+}
+
+type ProjectResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Organization         types.String `tfsdk:"organization"`
+	Teams                types.Set    `tfsdk:"teams"`
+	TeamsAuthoritative   types.Bool   `tfsdk:"teams_authoritative"`
+	Name                 types.String `tfsdk:"name"`
+	Slug                 types.String `tfsdk:"slug"`
+	Platform             types.String `tfsdk:"platform"`
+	DefaultRules         types.Bool   `tfsdk:"default_rules"`
+	DefaultKey           types.Bool   `tfsdk:"default_key"`
+	InternalId           types.String `tfsdk:"internal_id"`
+	IsPublic             types.Bool   `tfsdk:"is_public"`
+	Color                types.String `tfsdk:"color"`
+	Features             types.List   `tfsdk:"features"`
+	Status               types.String `tfsdk:"status"`
+	DigestsMinDelay      types.Int64  `tfsdk:"digests_min_delay"`
+	DigestsMaxDelay      types.Int64  `tfsdk:"digests_max_delay"`
+	ResolveAge           types.Int64  `tfsdk:"resolve_age"`
+	ScrubData            types.Bool   `tfsdk:"scrub_data"`
+	SensitiveFields      types.Set    `tfsdk:"sensitive_fields"`
+	SafeFields           types.Set    `tfsdk:"safe_fields"`
+	ScrubIpAddress       types.Bool   `tfsdk:"scrub_ip_address"`
+	StoreCrashReports    types.Int64  `tfsdk:"store_crash_reports"`
+	SubjectPrefix        types.String `tfsdk:"subject_prefix"`
+	HighlightTags        types.List   `tfsdk:"highlight_tags"`
+	VerifySsl            types.Bool   `tfsdk:"verify_ssl"`
+	TokenHeader          types.String `tfsdk:"token_header"`
+	RemoveDefaultKey     types.Bool   `tfsdk:"remove_default_key"`
+	RemoveDefaultRule    types.Bool   `tfsdk:"remove_default_rule"`
+	AllowedDomains       types.Set    `tfsdk:"allowed_domains"`
+	GroupingEnhancements types.String `tfsdk:"grouping_enhancements"`
+}
+
+func stringsToValues(values []string) []attr.Value {
+	elements := []attr.Value{}
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return elements
+}
+
+func (m *ProjectResourceModel) Fill(organization string, project sentry.Project) error {
+	m.Id = types.StringValue(project.Slug)
+	m.Organization = types.StringValue(organization)
+	m.Name = types.StringValue(project.Name)
+	m.Slug = types.StringValue(project.Slug)
+	m.Platform = types.StringValue(project.Platform)
+	m.InternalId = types.StringValue(project.ID)
+	m.IsPublic = types.BoolValue(project.IsPublic)
+	m.Color = types.StringValue(project.Color)
+	m.Features = types.ListValueMust(types.StringType, stringsToValues(project.Features))
+	m.Status = types.StringValue(project.Status)
+	m.DigestsMinDelay = types.Int64Value(int64(project.DigestsMinDelay))
+	m.DigestsMaxDelay = types.Int64Value(int64(project.DigestsMaxDelay))
+	m.ResolveAge = types.Int64Value(int64(project.ResolveAge))
+	m.AllowedDomains = types.SetValueMust(types.StringType, stringsToValues(project.AllowedDomains))
+	m.GroupingEnhancements = types.StringValue(project.GroupingEnhancements)
+
+	if m.TeamsAuthoritative.IsNull() {
+		m.TeamsAuthoritative = types.BoolValue(true)
+	}
+
+	// When teams are not authoritative, only the teams managed by this
+	// resource are tracked, so associations made by `sentry_project_team`
+	// do not show up as drift.
+	onlyManagedTeams := !m.TeamsAuthoritative.ValueBool() && !m.Teams.IsNull() && !m.Teams.IsUnknown()
+	managedTeams := m.Teams.Elements()
+	teams := []string{}
+	for _, team := range project.Teams {
+		slug := sentry.StringValue(team.Slug)
+		if onlyManagedTeams && !slices.ContainsFunc(managedTeams, types.StringValue(slug).Equal) {
+			continue
+		}
+		teams = append(teams, slug)
+	}
+	m.Teams = types.SetValueMust(types.StringType, stringsToValues(teams))
+
+	// Create time only settings are not returned by the API.
+	if m.DefaultRules.IsNull() {
+		m.DefaultRules = types.BoolValue(true)
+	}
+	if m.DefaultKey.IsNull() {
+		m.DefaultKey = types.BoolValue(true)
+	}
+	if m.RemoveDefaultKey.IsNull() {
+		m.RemoveDefaultKey = types.BoolValue(false)
+	}
+	if m.RemoveDefaultRule.IsNull() {
+		m.RemoveDefaultRule = types.BoolValue(false)
+	}
+
+	return nil
+}
+
+func (m *ProjectResourceModel) FillOptions(options sentryclient.ProjectOptions) error {
+	m.ScrubData = types.BoolValue(options.DataScrubber)
+	m.SensitiveFields = types.SetValueMust(types.StringType, stringsToValues(options.SensitiveFields))
+	m.SafeFields = types.SetValueMust(types.StringType, stringsToValues(options.SafeFields))
+	m.ScrubIpAddress = types.BoolValue(options.ScrubIPAddresses)
+	m.StoreCrashReports = types.Int64Null()
+	if options.StoreCrashReports != nil {
+		m.StoreCrashReports = types.Int64Value(int64(*options.StoreCrashReports))
+	}
+	m.SubjectPrefix = types.StringValue(options.SubjectPrefix)
+	m.HighlightTags = types.ListValueMust(types.StringType, stringsToValues(options.HighlightTags))
+	m.VerifySsl = types.BoolValue(options.VerifySSL)
+	m.TokenHeader = types.StringValue(sentry.StringValue(options.SecurityTokenHeader))
+	return nil
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project resource.\n\n" +
+			"The project is identified by its `internal_id`, so renaming its slug outside of Terraform does not recreate it: the new slug is picked up on the next refresh.",
+
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The slug of this project. It follows `slug` so that other resources can keep referencing the project by `id`; the project itself is tracked by `internal_id`.",
+				Computed:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"teams": schema.SetAttribute{
+				MarkdownDescription: "The slugs of the teams to create the project for.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"teams_authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Whether `teams` is the full list of teams of the project. When `false`, teams added outside of this resource, for example with the `sentry_project_team` resource, are left alone: only the teams listed in `teams` are added, and removed once they are dropped from the list. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name for the project.",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The optional slug for this project.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "The platform for this project. For a list of valid values, [see this page](https://github.com/jianyuan/terraform-provider-sentry/blob/main/internal/sentryplatforms/platforms.txt). Use `other` for platforms not listed.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					platformValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_rules": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create a default issue alert. Defaults to true where the behavior is to alert the user on every new issue.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"default_key": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create a default key. By default, Sentry will create a key for you. If you wish to manage keys manually, set this to false and create keys using the `sentry_key` resource.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"internal_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID for this project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_public": schema.BoolAttribute{
+				MarkdownDescription: "Whether this project is public.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "The color of this project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"features": schema.ListAttribute{
+				MarkdownDescription: "The features enabled for this project.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of this project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"digests_min_delay": schema.Int64Attribute{
+				MarkdownDescription: "The minimum amount of time (in seconds) to wait between scheduling digests for delivery after the initial scheduling.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"digests_max_delay": schema.Int64Attribute{
+				MarkdownDescription: "The maximum amount of time (in seconds) to wait between scheduling digests for delivery.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"resolve_age": schema.Int64Attribute{
+				MarkdownDescription: "Hours in which an issue is automatically resolve if not seen after this amount of time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"scrub_data": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable server-side data scrubbing (`sentry:scrub_data`).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sensitive_fields": schema.SetAttribute{
+				MarkdownDescription: "Additional field names to match against when scrubbing data (`sentry:sensitive_fields`).",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"safe_fields": schema.SetAttribute{
+				MarkdownDescription: "Field names which data scrubbers should ignore (`sentry:safe_fields`).",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scrub_ip_address": schema.BoolAttribute{
+				MarkdownDescription: "Whether to prevent IP addresses from being stored for new events (`sentry:scrub_ip_address`).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"store_crash_reports": schema.Int64Attribute{
+				MarkdownDescription: "The number of native crash reports to store per issue (`sentry:store_crash_reports`). `0` disables storing crash reports and `-1` stores all of them.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject_prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix of the subject of email notifications.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"highlight_tags": schema.ListAttribute{
+				MarkdownDescription: "The tags highlighted on the issue details page.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verify_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Whether outbound requests, e.g. for source maps, verify the TLS certificate.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_header": schema.StringAttribute{
+				MarkdownDescription: "The header used to send the security token when fetching source files (`sentry:token_header`), e.g. `X-Sentry-Token`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remove_default_key": schema.BoolAttribute{
+				MarkdownDescription: "Whether to remove the key Sentry created along with the project. Only that key is removed, keys created afterwards are left alone. Has no effect on projects that were imported, or created by an earlier version of the provider.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"remove_default_rule": schema.BoolAttribute{
+				MarkdownDescription: "Whether to remove the issue alert rule Sentry created along with the project. Only that rule is removed, rules created afterwards are left alone. Has no effect on projects that were imported, or created by an earlier version of the provider.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allowed_domains": schema.SetAttribute{
+				MarkdownDescription: "The domains allowed to be collected",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"grouping_enhancements": schema.StringAttribute{
+				MarkdownDescription: "Grouping enhancements pattern",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan keeps the ID in step with the slug, so that resources referencing
+// the project only see a change when the slug changes.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var slug types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("slug"), &slug)...)
+	if resp.Diagnostics.HasError() || slug.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), slug)...)
+}
+
+// readProject returns the project with the given slug. Once the internal ID is
+// known, it takes precedence: a project whose slug was changed outside of
+// Terraform is looked up by its internal ID instead.
+func (r *ProjectResource) readProject(ctx context.Context, organization string, slug string, internalId string) (*sentry.Project, error) {
+	project, apiResp, err := r.client.Projects.Get(ctx, organization, slug)
+	if err == nil && (internalId == "" || project.ID == internalId) {
+		return project, nil
+	}
+	if apiResp == nil || (err != nil && apiResp.StatusCode != http.StatusNotFound) {
+		return nil, err
+	}
+	if internalId == "" {
+		return nil, errProjectNotFound
+	}
+
+	tflog.Debug(ctx, "Project slug no longer resolves to the project, looking it up by internal ID", map[string]interface{}{
+		"org":        organization,
+		"slug":       slug,
+		"internalId": internalId,
+	})
+	projects, _, err := r.client.OrganizationProjects.List(ctx, organization, &sentry.ListOrganizationProjectsParams{
+		Query: "id:" + internalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range projects {
+		if candidate.ID == internalId {
+			project, _, err := r.client.Projects.Get(ctx, organization, candidate.Slug)
+			return project, err
+		}
+	}
+
+	return nil, errProjectNotFound
+}
+
+// fill reads the project and its options back into the model.
+func (r *ProjectResource) fill(ctx context.Context, data *ProjectResourceModel, slug string) error {
+	project, err := r.readProject(ctx, data.Organization.ValueString(), slug, data.InternalId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	options, _, err := sentryclient.GetProjectOptions(ctx, r.client, data.Organization.ValueString(), project.Slug)
+	if err != nil {
+		return err
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), *project); err != nil {
+		return err
+	}
+	return data.FillOptions(*options)
+}
+
+// projectOptionsParams returns the project options set in the configuration,
+// or nil if none are. Options that are not configured are left untouched.
+func projectOptionsParams(ctx context.Context, config ProjectResourceModel) (*sentryclient.ProjectOptionsParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := map[string]interface{}{}
+	if !config.ScrubData.IsNull() {
+		options[sentryclient.ProjectOptionScrubData] = config.ScrubData.ValueBool()
+	}
+	if !config.SensitiveFields.IsNull() {
+		sensitiveFields := []string{}
+		diags.Append(config.SensitiveFields.ElementsAs(ctx, &sensitiveFields, false)...)
+		options[sentryclient.ProjectOptionSensitiveFields] = sensitiveFields
+	}
+	if !config.SafeFields.IsNull() {
+		safeFields := []string{}
+		diags.Append(config.SafeFields.ElementsAs(ctx, &safeFields, false)...)
+		options[sentryclient.ProjectOptionSafeFields] = safeFields
+	}
+	if !config.ScrubIpAddress.IsNull() {
+		options[sentryclient.ProjectOptionScrubIPAddress] = config.ScrubIpAddress.ValueBool()
+	}
+	if !config.StoreCrashReports.IsNull() {
+		options[sentryclient.ProjectOptionStoreCrashReports] = config.StoreCrashReports.ValueInt64()
+	}
+	if !config.TokenHeader.IsNull() {
+		options[sentryclient.ProjectOptionTokenHeader] = config.TokenHeader.ValueString()
+	}
+
+	params := &sentryclient.ProjectOptionsParams{
+		SubjectPrefix: config.SubjectPrefix.ValueStringPointer(),
+		VerifySSL:     config.VerifySsl.ValueBoolPointer(),
+	}
+	if len(options) > 0 {
+		params.Options = options
+	}
+	if !config.HighlightTags.IsNull() {
+		highlightTags := []string{}
+		diags.Append(config.HighlightTags.ElementsAs(ctx, &highlightTags, false)...)
+		params.HighlightTags = &highlightTags
+	}
+
+	if params.Options == nil && params.SubjectPrefix == nil && params.HighlightTags == nil && params.VerifySSL == nil {
+		return nil, diags
+	}
+	return params, diags
+}
+
+// apply updates the project settings and teams to match the plan, and fills
+// the model with the result. oldTeams are the teams currently assigned.
+func (r *ProjectResource) apply(ctx context.Context, data *ProjectResourceModel, config ProjectResourceModel, slug string, oldTeams []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	organization := data.Organization.ValueString()
+
+	params := &sentry.UpdateProjectParams{
+		Name:                 data.Name.ValueString(),
+		Slug:                 data.Slug.ValueString(),
+		Platform:             data.Platform.ValueString(),
+		GroupingEnhancements: data.GroupingEnhancements.ValueString(),
+	}
+	if !data.DigestsMinDelay.IsNull() && !data.DigestsMinDelay.IsUnknown() {
+		params.DigestsMinDelay = sentry.Int(int(data.DigestsMinDelay.ValueInt64()))
+	}
+	if !data.DigestsMaxDelay.IsNull() && !data.DigestsMaxDelay.IsUnknown() {
+		params.DigestsMaxDelay = sentry.Int(int(data.DigestsMaxDelay.ValueInt64()))
+	}
+	if !data.ResolveAge.IsNull() && !data.ResolveAge.IsUnknown() {
+		params.ResolveAge = sentry.Int(int(data.ResolveAge.ValueInt64()))
+	}
+	if !data.AllowedDomains.IsNull() && !data.AllowedDomains.IsUnknown() {
+		diags.Append(data.AllowedDomains.ElementsAs(ctx, &params.AllowedDomains, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Updating project", map[string]interface{}{
+		"org":     organization,
+		"project": slug,
+	})
+	project, _, err := r.client.Projects.Update(ctx, organization, slug, params)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error updating project: %s", err.Error()))
+		return diags
+	}
+	slug = project.Slug
+
+	optionsParams, optionsDiags := projectOptionsParams(ctx, config)
+	diags.Append(optionsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if optionsParams != nil {
+		if _, _, err := sentryclient.UpdateProjectOptions(ctx, r.client, organization, slug, optionsParams); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Error updating project options: %s", err.Error()))
+			return diags
+		}
+	}
+
+	var newTeams []string
+	diags.Append(data.Teams.ElementsAs(ctx, &newTeams, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, team := range newTeams {
+		if slices.Contains(oldTeams, team) {
+			continue
+		}
+		tflog.Debug(ctx, "Adding team to project", map[string]interface{}{
+			"org":     organization,
+			"project": slug,
+			"team":    team,
+		})
+		if _, _, err := r.client.Projects.AddTeam(ctx, organization, slug, team); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Error adding team %s to project: %s", team, err.Error()))
+			return diags
+		}
+	}
+
+	for _, team := range oldTeams {
+		if slices.Contains(newTeams, team) {
+			continue
+		}
+		tflog.Debug(ctx, "Removing team from project", map[string]interface{}{
+			"org":     organization,
+			"project": slug,
+			"team":    team,
+		})
+		apiResp, err := r.client.Projects.RemoveTeam(ctx, organization, slug, team)
+		if err != nil && (apiResp == nil || apiResp.StatusCode != http.StatusNotFound) {
+			diags.AddError("Client Error", fmt.Sprintf("Error removing team %s from project: %s", team, err.Error()))
+			return diags
+		}
+	}
+
+	if err := r.fill(ctx, data, slug); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading project: %s", err.Error()))
+	}
+	return diags
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teams []string
+	resp.Diagnostics.Append(data.Teams.ElementsAs(ctx, &teams, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pick the first team in sorted order when creating the project, the
+	// remaining teams are added afterwards.
+	sort.Strings(teams)
+	initialTeam := teams[0]
+
+	params := &sentry.CreateProjectParams{
+		Name:         data.Name.ValueString(),
+		Slug:         data.Slug.ValueString(),
+		Platform:     data.Platform.ValueString(),
+		DefaultRules: data.DefaultRules.ValueBoolPointer(),
+	}
+
+	tflog.Debug(ctx, "Creating Sentry project", map[string]interface{}{
+		"org":          data.Organization.ValueString(),
+		"teams":        teams,
+		"initialTeam":  initialTeam,
+		"defaultRules": params.DefaultRules,
+	})
+	project, _, err := r.client.Projects.Create(ctx, data.Organization.ValueString(), initialTeam, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating project: %s", err.Error()))
+		return
+	}
+
+	data.InternalId = types.StringValue(project.ID)
+	data.Slug = types.StringValue(project.Slug)
+
+	// Save the identity of the project before removing the defaults and
+	// applying the remaining settings, so that a failure leaves a tainted
+	// resource to replace instead of an orphaned project.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), project.Slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), data.Organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("internal_id"), data.InternalId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), data.Slug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaults, err := r.listDefaults(ctx, data.Organization.ValueString(), project.Slug, data.DefaultRules.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading the default key and rule of the project: %s", err.Error()))
+		return
+	}
+	defaults, diags := r.removeDefaults(ctx, data, project.Slug, defaults, !data.DefaultKey.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyProjectDefaults, must.Get(json.Marshal(defaults)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, config, project.Slug, []string{initialTeam})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.fill(ctx, &data, data.Id.ValueString())
+	if errors.Is(err, errProjectNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config, state ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var oldTeams []string
+	resp.Diagnostics.Append(state.Teams.ElementsAs(ctx, &oldTeams, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.InternalId = state.InternalId
+
+	defaults, diags := projectDefaultsFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if defaults.pending() {
+		defaults, diags = r.removeDefaults(ctx, plan, state.Id.ValueString(), defaults, false)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyProjectDefaults, must.Get(json.Marshal(defaults)))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, config, state.Id.ValueString(), oldTeams)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Sentry project", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Id.ValueString(),
+	})
+	apiResp, err := r.client.Projects.Delete(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting project: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, err := splitTwoPartID(req.ID, "organization", "project-slug")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), project,
+	)...)
+}
+
+func (r *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	type modelV0 struct {
+		Id                   types.String `tfsdk:"id"`
+		Organization         types.String `tfsdk:"organization"`
+		Team                 types.String `tfsdk:"team"`
+		Teams                types.Set    `tfsdk:"teams"`
+		Name                 types.String `tfsdk:"name"`
+		Slug                 types.String `tfsdk:"slug"`
+		Platform             types.String `tfsdk:"platform"`
+		DefaultRules         types.Bool   `tfsdk:"default_rules"`
+		DefaultKey           types.Bool   `tfsdk:"default_key"`
+		InternalId           types.String `tfsdk:"internal_id"`
+		IsPublic             types.Bool   `tfsdk:"is_public"`
+		IsBookmarked         types.Bool   `tfsdk:"is_bookmarked"`
+		Color                types.String `tfsdk:"color"`
+		Features             types.List   `tfsdk:"features"`
+		Status               types.String `tfsdk:"status"`
+		DigestsMinDelay      types.Int64  `tfsdk:"digests_min_delay"`
+		DigestsMaxDelay      types.Int64  `tfsdk:"digests_max_delay"`
+		ResolveAge           types.Int64  `tfsdk:"resolve_age"`
+		ProjectId            types.String `tfsdk:"project_id"`
+		ScrubData            types.Bool   `tfsdk:"scrub_data"`
+		SensitiveFields      types.Set    `tfsdk:"sensitive_fields"`
+		SafeFields           types.Set    `tfsdk:"safe_fields"`
+		ScrubIpAddress       types.Bool   `tfsdk:"scrub_ip_address"`
+		StoreCrashReports    types.Int64  `tfsdk:"store_crash_reports"`
+		SubjectPrefix        types.String `tfsdk:"subject_prefix"`
+		HighlightTags        types.List   `tfsdk:"highlight_tags"`
+		VerifySsl            types.Bool   `tfsdk:"verify_ssl"`
+		TokenHeader          types.String `tfsdk:"token_header"`
+		RemoveDefaultKey     types.Bool   `tfsdk:"remove_default_key"`
+		RemoveDefaultRule    types.Bool   `tfsdk:"remove_default_rule"`
+		AllowedDomains       types.Set    `tfsdk:"allowed_domains"`
+		GroupingEnhancements types.String `tfsdk:"grouping_enhancements"`
+	}
+
+	return map[int64]resource.StateUpgrader{
+		// Version 0 is the plugin SDK implementation.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                    schema.StringAttribute{Computed: true},
+					"organization":          schema.StringAttribute{Required: true},
+					"team":                  schema.StringAttribute{Optional: true},
+					"teams":                 schema.SetAttribute{Optional: true, ElementType: types.StringType},
+					"name":                  schema.StringAttribute{Required: true},
+					"slug":                  schema.StringAttribute{Optional: true, Computed: true},
+					"platform":              schema.StringAttribute{Optional: true, Computed: true},
+					"default_rules":         schema.BoolAttribute{Optional: true},
+					"default_key":           schema.BoolAttribute{Optional: true},
+					"internal_id":           schema.StringAttribute{Computed: true},
+					"is_public":             schema.BoolAttribute{Computed: true},
+					"is_bookmarked":         schema.BoolAttribute{Computed: true},
+					"color":                 schema.StringAttribute{Computed: true},
+					"features":              schema.ListAttribute{Computed: true, ElementType: types.StringType},
+					"status":                schema.StringAttribute{Computed: true},
+					"digests_min_delay":     schema.Int64Attribute{Optional: true, Computed: true},
+					"digests_max_delay":     schema.Int64Attribute{Optional: true, Computed: true},
+					"resolve_age":           schema.Int64Attribute{Optional: true, Computed: true},
+					"project_id":            schema.StringAttribute{Computed: true},
+					"scrub_data":            schema.BoolAttribute{Optional: true, Computed: true},
+					"sensitive_fields":      schema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+					"safe_fields":           schema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+					"scrub_ip_address":      schema.BoolAttribute{Optional: true, Computed: true},
+					"store_crash_reports":   schema.Int64Attribute{Optional: true, Computed: true},
+					"subject_prefix":        schema.StringAttribute{Optional: true, Computed: true},
+					"highlight_tags":        schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+					"verify_ssl":            schema.BoolAttribute{Optional: true, Computed: true},
+					"token_header":          schema.StringAttribute{Optional: true, Computed: true},
+					"remove_default_key":    schema.BoolAttribute{Optional: true},
+					"remove_default_rule":   schema.BoolAttribute{Optional: true},
+					"allowed_domains":       schema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+					"grouping_enhancements": schema.StringAttribute{Optional: true, Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData modelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// The deprecated `team` is folded into `teams`.
+				teams := priorStateData.Teams
+				if (teams.IsNull() || len(teams.Elements()) == 0) && priorStateData.Team.ValueString() != "" {
+					teams = types.SetValueMust(types.StringType, []attr.Value{priorStateData.Team})
+				}
+
+				internalId := priorStateData.InternalId
+				if internalId.ValueString() == "" {
+					internalId = priorStateData.ProjectId
+				}
+
+				upgradedStateData := ProjectResourceModel{
+					Id:                   priorStateData.Id,
+					Organization:         priorStateData.Organization,
+					Teams:                teams,
+					TeamsAuthoritative:   types.BoolValue(true),
+					Name:                 priorStateData.Name,
+					Slug:                 priorStateData.Slug,
+					Platform:             priorStateData.Platform,
+					DefaultRules:         priorStateData.DefaultRules,
+					DefaultKey:           priorStateData.DefaultKey,
+					InternalId:           internalId,
+					IsPublic:             priorStateData.IsPublic,
+					Color:                priorStateData.Color,
+					Features:             priorStateData.Features,
+					Status:               priorStateData.Status,
+					DigestsMinDelay:      priorStateData.DigestsMinDelay,
+					DigestsMaxDelay:      priorStateData.DigestsMaxDelay,
+					ResolveAge:           priorStateData.ResolveAge,
+					ScrubData:            priorStateData.ScrubData,
+					SensitiveFields:      priorStateData.SensitiveFields,
+					SafeFields:           priorStateData.SafeFields,
+					ScrubIpAddress:       priorStateData.ScrubIpAddress,
+					StoreCrashReports:    priorStateData.StoreCrashReports,
+					SubjectPrefix:        priorStateData.SubjectPrefix,
+					HighlightTags:        priorStateData.HighlightTags,
+					VerifySsl:            priorStateData.VerifySsl,
+					TokenHeader:          priorStateData.TokenHeader,
+					RemoveDefaultKey:     priorStateData.RemoveDefaultKey,
+					RemoveDefaultRule:    priorStateData.RemoveDefaultRule,
+					AllowedDomains:       priorStateData.AllowedDomains,
+					GroupingEnhancements: priorStateData.GroupingEnhancements,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgradedStateData)...)
+			},
+		},
+	}
+}
+
+// privateKeyProjectDefaults holds the IDs of the key and issue alert rule
+// that Sentry created along with the project, until they are removed.
+const privateKeyProjectDefaults = "project_defaults"
+
+type projectDefaults struct {
+	KeyIds  []string `json:"key_ids,omitempty"`
+	RuleIds []string `json:"rule_ids,omitempty"`
+}
+
+// pending reports whether any of the default objects have not been removed.
+func (d projectDefaults) pending() bool {
+	return len(d.KeyIds) > 0 || len(d.RuleIds) > 0
+}
+
+func projectDefaultsFromPrivate(ctx context.Context, private privateStateGetter) (projectDefaults, diag.Diagnostics) {
+	var defaults projectDefaults
+
+	value, diags := private.GetKey(ctx, privateKeyProjectDefaults)
+	if diags.HasError() || len(value) == 0 {
+		return defaults, diags
+	}
+
+	if err := json.Unmarshal(value, &defaults); err != nil {
+		diags.AddError("Private State Error", fmt.Sprintf("Error reading the default key and rule of the project: %s", err.Error()))
+	}
+	return defaults, diags
+}
+
+// listDefaults returns the keys and issue alert rules of a project that was
+// just created, which are the ones Sentry created by default.
+func (r *ProjectResource) listDefaults(ctx context.Context, organization string, slug string, defaultRules bool) (projectDefaults, error) {
+	var defaults projectDefaults
+
+	keys, _, err := r.client.ProjectKeys.List(ctx, organization, slug, nil)
+	if err != nil {
+		return defaults, err
+	}
+	for _, key := range keys {
+		defaults.KeyIds = append(defaults.KeyIds, key.ID)
+	}
+
+	if defaultRules {
+		rules, _, err := r.client.IssueAlerts.List(ctx, organization, slug, nil)
+		if err != nil {
+			return defaults, err
+		}
+		for _, rule := range rules {
+			defaults.RuleIds = append(defaults.RuleIds, sentry.StringValue(rule.ID))
+		}
+	}
+
+	return defaults, nil
+}
+
+// removeDefaults deletes the default key and rule recorded when the project was
+// created, if their removal is requested, and returns the ones that are left.
+// Keys and rules created afterwards are never touched.
+func (r *ProjectResource) removeDefaults(ctx context.Context, data ProjectResourceModel, slug string, defaults projectDefaults, removeKey bool) (projectDefaults, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	organization := data.Organization.ValueString()
+
+	if removeKey || data.RemoveDefaultKey.ValueBool() {
+		for len(defaults.KeyIds) > 0 {
+			keyId := defaults.KeyIds[0]
+			tflog.Debug(ctx, "Removing default key", map[string]interface{}{
+				"org":     organization,
+				"project": slug,
+				"keyId":   keyId,
+			})
+			apiResp, err := r.client.ProjectKeys.Delete(ctx, organization, slug, keyId)
+			if err != nil && (apiResp == nil || apiResp.StatusCode != http.StatusNotFound) {
+				diags.AddError("Client Error", fmt.Sprintf("Error removing default key: %s", err.Error()))
+				return defaults, diags
+			}
+			defaults.KeyIds = defaults.KeyIds[1:]
+		}
+	}
+
+	if data.RemoveDefaultRule.ValueBool() {
+		for len(defaults.RuleIds) > 0 {
+			ruleId := defaults.RuleIds[0]
+			tflog.Debug(ctx, "Removing default rule", map[string]interface{}{
+				"org":     organization,
+				"project": slug,
+				"ruleId":  ruleId,
+			})
+			apiResp, err := r.client.IssueAlerts.Delete(ctx, organization, slug, ruleId)
+			if err != nil && (apiResp == nil || apiResp.StatusCode != http.StatusNotFound) {
+				diags.AddError("Client Error", fmt.Sprintf("Error removing default rule: %s", err.Error()))
+				return defaults, diags
+			}
+			defaults.RuleIds = defaults.RuleIds[1:]
+		}
+	}
+
+	return defaults, diags
+}
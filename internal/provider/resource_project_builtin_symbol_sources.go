@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectBuiltinSymbolSourcesResource{}
+var _ resource.ResourceWithConfigure = &ProjectBuiltinSymbolSourcesResource{}
+var _ resource.ResourceWithImportState = &ProjectBuiltinSymbolSourcesResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectBuiltinSymbolSourcesResource{}
+
+func NewProjectBuiltinSymbolSourcesResource() resource.Resource {
+	return &ProjectBuiltinSymbolSourcesResource{}
+}
+
+type ProjectBuiltinSymbolSourcesResource struct {
+	baseResource
+}
+
+type ProjectBuiltinSymbolSourcesResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Sources      types.Set    `tfsdk:"sources"`
+}
+
+func (m *ProjectBuiltinSymbolSourcesResourceModel) Fill(organization string, project string, options sentryclient.ProjectOptions) error {
+	m.Id = types.StringValue(buildTwoPartID(organization, project))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Sources = types.SetValueMust(types.StringType, stringsToValues(options.BuiltinSymbolSources))
+
+	return nil
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_builtin_symbol_sources"
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Built-in Symbol Sources resource. Manages which of the symbol servers provided by Sentry, e.g. the Microsoft or NVIDIA symbol servers, are queried for the native crashes of a project. Use `sentry_project_symbol_source` for custom sources and the `sentry_builtin_symbol_sources` data source to list the available ones.\n\n" +
+			"Destroying the resource leaves the built-in symbol sources of the project as they are.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sources": schema.SetAttribute{
+				MarkdownDescription: "The keys of the enabled built-in symbol sources, e.g. `microsoft` or `nvidia`. An empty set disables all of them.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan checks the sources against the built-in symbol sources of the
+// organization, as the available sources change between Sentry versions.
+func (r *ProjectBuiltinSymbolSourcesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var organization types.String
+	var sources types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("sources"), &sources)...)
+	if resp.Diagnostics.HasError() || organization.IsUnknown() || sources.IsUnknown() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(sources.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	builtinSources, _, err := sentryclient.ListBuiltinSymbolSources(ctx, r.client, organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("sources"),
+			"Unable to Validate Built-in Symbol Sources",
+			fmt.Sprintf("The built-in symbol sources could not be listed, so %v were not checked: %s", keys, err.Error()),
+		)
+		return
+	}
+
+	validKeys := make([]string, 0, len(builtinSources))
+	for _, source := range builtinSources {
+		validKeys = append(validKeys, source.Key)
+	}
+	slices.Sort(validKeys)
+
+	for _, key := range keys {
+		if !slices.Contains(validKeys, key) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sources"),
+				"Unknown Built-in Symbol Source",
+				fmt.Sprintf("%q is not a built-in symbol source. Valid sources: %v.", key, validKeys),
+			)
+		}
+	}
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) update(ctx context.Context, data *ProjectBuiltinSymbolSourcesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sources := []string{}
+	diags.Append(data.Sources.ElementsAs(ctx, &sources, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	slices.Sort(sources)
+
+	tflog.Debug(ctx, "Updating project built-in symbol sources", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Project.ValueString(),
+		"sources": sources,
+	})
+	options, _, err := sentryclient.UpdateProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.ProjectOptionsParams{
+		BuiltinSymbolSources: &sources,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error updating project built-in symbol sources: %s", err.Error()))
+		return diags
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *options); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+	}
+	return diags
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectBuiltinSymbolSourcesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectBuiltinSymbolSourcesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, apiResp, err := sentryclient.GetProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Project.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectBuiltinSymbolSourcesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Sentry cannot reset the option to the platform default, so the sources
+	// are left as they are.
+}
+
+func (r *ProjectBuiltinSymbolSourcesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, err := splitTwoPartID(req.ID, "organization", "project-slug")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccProjectBuiltinSymbolSourcesResource(t *testing.T) {
+	rn := "sentry_project_builtin_symbol_sources.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectBuiltinSymbolSourcesResourceConfig(team, project, `["microsoft", "not-a-source"]`),
+				ExpectError: regexp.MustCompile(`"not-a-source" is not a built-in symbol source`),
+			},
+			{
+				Config: testAccProjectBuiltinSymbolSourcesResourceConfig(team, project, `["microsoft", "nvidia"]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(fmt.Sprintf("%s/%s", acctest.TestOrganization, project))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sources"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("microsoft"),
+						knownvalue.StringExact("nvidia"),
+					})),
+				},
+			},
+			{
+				Config: testAccProjectBuiltinSymbolSourcesResourceConfig(team, project, `[]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sources"), knownvalue.SetExact([]knownvalue.Check{})),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectBuiltinSymbolSourcesResourceConfig(team, project, sources string) string {
+	return testAccProjectResourceConfig(team, project) + fmt.Sprintf(`
+resource "sentry_project_builtin_symbol_sources" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	sources      = %[1]s
+}
+`, sources)
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectCodeOwnersResource{}
+var _ resource.ResourceWithConfigure = &ProjectCodeOwnersResource{}
+var _ resource.ResourceWithImportState = &ProjectCodeOwnersResource{}
+
+func NewProjectCodeOwnersResource() resource.Resource {
+	return &ProjectCodeOwnersResource{}
+}
+
+type ProjectCodeOwnersResource struct {
+	baseResource
+}
+
+type ProjectCodeOwnersResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Organization    types.String `tfsdk:"organization"`
+	Project         types.String `tfsdk:"project"`
+	CodeMappingId   types.String `tfsdk:"code_mapping_id"`
+	Raw             types.String `tfsdk:"raw"`
+	OwnershipSyntax types.String `tfsdk:"ownership_syntax"`
+}
+
+func (m *ProjectCodeOwnersResourceModel) Fill(organization string, project string, codeOwners sentryclient.ProjectCodeOwners) error {
+	m.Id = types.StringValue(codeOwners.ID)
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.CodeMappingId = types.StringValue(codeOwners.CodeMappingID)
+	m.Raw = types.StringValue(codeOwners.Raw)
+	m.OwnershipSyntax = types.StringValue(codeOwners.OwnershipSyntax)
+
+	return nil
+}
+
+// codeOwnersWarnings reports the owners of a CODEOWNERS file that Sentry could
+// not map to its users and teams. Sentry still accepts the file, and ignores
+// the rules of the owners it cannot resolve.
+func codeOwnersWarnings(errors sentryclient.ProjectCodeOwnersErrors) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, e := range []struct {
+		owners  []string
+		summary string
+	}{
+		{errors.MissingExternalTeams, "The following teams are not linked to a Sentry team"},
+		{errors.MissingExternalUsers, "The following usernames are not linked to a Sentry user"},
+		{errors.MissingUserEmails, "The following emails do not belong to a member of the organization"},
+		{errors.TeamsWithoutAccess, "The following teams do not have access to the project"},
+		{errors.UsersWithoutAccess, "The following users are not on a team that has access to the project"},
+	} {
+		if len(e.owners) == 0 {
+			continue
+		}
+		diags.AddAttributeWarning(
+			path.Root("raw"),
+			"Unmapped CODEOWNERS Owners",
+			fmt.Sprintf("%s: %s", e.summary, strings.Join(e.owners, ", ")),
+		)
+	}
+
+	return diags
+}
+
+func (r *ProjectCodeOwnersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_codeowners"
+}
+
+func (r *ProjectCodeOwnersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project CODEOWNERS resource. Imports a CODEOWNERS file into a project, so that Sentry uses it to assign issues.\n\n" +
+			"The file is tied to a code mapping, see the `sentry_organization_code_mapping` resource. Owners that Sentry cannot map to its users and teams are reported as warnings.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code_mapping_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the code mapping of the repository the CODEOWNERS file belongs to.",
+				Required:            true,
+			},
+			"raw": schema.StringAttribute{
+				MarkdownDescription: "The content of the CODEOWNERS file, e.g. `file(\"${path.module}/CODEOWNERS\")`.",
+				Required:            true,
+			},
+			"ownership_syntax": schema.StringAttribute{
+				MarkdownDescription: "The CODEOWNERS file translated to Sentry's ownership rules format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectCodeOwnersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating project CODEOWNERS", map[string]interface{}{
+		"org":           data.Organization.ValueString(),
+		"project":       data.Project.ValueString(),
+		"codeMappingId": data.CodeMappingId.ValueString(),
+	})
+	codeOwners, _, err := sentryclient.CreateProjectCodeOwners(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.ProjectCodeOwnersParams{
+		Raw:           data.Raw.ValueString(),
+		CodeMappingID: data.CodeMappingId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating project CODEOWNERS: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(codeOwnersWarnings(codeOwners.Errors)...)
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *codeOwners); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectCodeOwnersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codeOwnersList, apiResp, err := sentryclient.ListProjectCodeOwners(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Project.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project CODEOWNERS: %s", err.Error()))
+		return
+	}
+
+	var codeOwners *sentryclient.ProjectCodeOwners
+	for _, candidate := range codeOwnersList {
+		if candidate.ID == data.Id.ValueString() {
+			codeOwners = candidate
+			break
+		}
+	}
+	if codeOwners == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project CODEOWNERS not found: %s", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *codeOwners); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectCodeOwnersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating project CODEOWNERS", map[string]interface{}{
+		"org":           data.Organization.ValueString(),
+		"project":       data.Project.ValueString(),
+		"id":            data.Id.ValueString(),
+		"codeMappingId": data.CodeMappingId.ValueString(),
+	})
+	codeOwners, _, err := sentryclient.UpdateProjectCodeOwners(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString(), &sentryclient.ProjectCodeOwnersParams{
+		Raw:           data.Raw.ValueString(),
+		CodeMappingID: data.CodeMappingId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating project CODEOWNERS: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(codeOwnersWarnings(codeOwners.Errors)...)
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *codeOwners); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectCodeOwnersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := sentryclient.DeleteProjectCodeOwners(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting project CODEOWNERS: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectCodeOwnersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, id, err := splitThreePartID(req.ID, "organization", "project-slug", "id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), id,
+	)...)
+}
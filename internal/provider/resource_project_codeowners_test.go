@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestCodeOwnersWarnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		errors   sentryclient.ProjectCodeOwnersErrors
+		expected []string
+	}{
+		"no errors": {},
+		"unmapped owners": {
+			errors: sentryclient.ProjectCodeOwnersErrors{
+				MissingExternalTeams: []string{"@acme/payments", "@acme/checkout"},
+				MissingUserEmails:    []string{"jane@example.com"},
+			},
+			expected: []string{
+				"The following teams are not linked to a Sentry team: @acme/payments, @acme/checkout",
+				"The following emails do not belong to a member of the organization: jane@example.com",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := codeOwnersWarnings(tc.errors)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(diags) != len(tc.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tc.expected), len(diags), diags)
+			}
+			for i, d := range diags {
+				if d.Detail() != tc.expected[i] {
+					t.Errorf("expected warning %q, got %q", tc.expected[i], d.Detail())
+				}
+			}
+		})
+	}
+}
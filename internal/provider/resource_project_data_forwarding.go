@@ -0,0 +1,489 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectDataForwardingResource{}
+var _ resource.ResourceWithConfigure = &ProjectDataForwardingResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectDataForwardingResource{}
+var _ resource.ResourceWithImportState = &ProjectDataForwardingResource{}
+
+// The IDs of the data forwarding plugins.
+const (
+	dataForwardingPluginSegment   = "segment"
+	dataForwardingPluginSplunk    = "splunk"
+	dataForwardingPluginAmazonSqs = "amazon-sqs"
+	dataForwardingPluginWebhooks  = "webhooks"
+)
+
+func NewProjectDataForwardingResource() resource.Resource {
+	return &ProjectDataForwardingResource{}
+}
+
+type ProjectDataForwardingResource struct {
+	baseResource
+}
+
+// The secret fields of the forwarders are write-only: Sentry masks them when
+// they are read back, so they are never filled from the API and keep the
+// value from the configuration.
+
+type ProjectDataForwardingSegmentModel struct {
+	WriteKey types.String `tfsdk:"write_key"`
+}
+
+type ProjectDataForwardingSplunkModel struct {
+	Instance types.String `tfsdk:"instance"`
+	Index    types.String `tfsdk:"index"`
+	Source   types.String `tfsdk:"source"`
+	Token    types.String `tfsdk:"token"`
+}
+
+type ProjectDataForwardingAmazonSqsModel struct {
+	QueueUrl       types.String `tfsdk:"queue_url"`
+	Region         types.String `tfsdk:"region"`
+	AccessKey      types.String `tfsdk:"access_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	MessageGroupId types.String `tfsdk:"message_group_id"`
+	S3Bucket       types.String `tfsdk:"s3_bucket"`
+}
+
+type ProjectDataForwardingWebhooksModel struct {
+	Urls types.Set `tfsdk:"urls"`
+}
+
+type ProjectDataForwardingResourceModel struct {
+	Id           types.String                         `tfsdk:"id"`
+	Organization types.String                         `tfsdk:"organization"`
+	Project      types.String                         `tfsdk:"project"`
+	Enabled      types.Bool                           `tfsdk:"enabled"`
+	Segment      *ProjectDataForwardingSegmentModel   `tfsdk:"segment"`
+	Splunk       *ProjectDataForwardingSplunkModel    `tfsdk:"splunk"`
+	AmazonSqs    *ProjectDataForwardingAmazonSqsModel `tfsdk:"amazon_sqs"`
+	Webhooks     *ProjectDataForwardingWebhooksModel  `tfsdk:"webhooks"`
+}
+
+// pluginConfigString returns a config value of a plugin, or null if it is not set.
+func pluginConfigString(plugin sentryclient.ProjectPlugin, name string) types.String {
+	if v := plugin.ConfigValue(name); v != "" {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
+
+func (m *ProjectDataForwardingResourceModel) Fill(organization string, project string, plugin sentryclient.ProjectPlugin) error {
+	m.Id = types.StringValue(buildThreePartID(organization, project, plugin.ID))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Enabled = types.BoolValue(plugin.Enabled)
+
+	segment, splunk, amazonSqs := m.Segment, m.Splunk, m.AmazonSqs
+	m.Segment, m.Splunk, m.AmazonSqs, m.Webhooks = nil, nil, nil, nil
+
+	switch plugin.ID {
+	case dataForwardingPluginSegment:
+		if segment == nil {
+			segment = &ProjectDataForwardingSegmentModel{WriteKey: types.StringNull()}
+		}
+		m.Segment = segment
+	case dataForwardingPluginSplunk:
+		if splunk == nil {
+			splunk = &ProjectDataForwardingSplunkModel{Token: types.StringNull()}
+		}
+		splunk.Instance = pluginConfigString(plugin, "instance")
+		splunk.Index = pluginConfigString(plugin, "index")
+		splunk.Source = pluginConfigString(plugin, "source")
+		m.Splunk = splunk
+	case dataForwardingPluginAmazonSqs:
+		if amazonSqs == nil {
+			amazonSqs = &ProjectDataForwardingAmazonSqsModel{SecretKey: types.StringNull()}
+		}
+		amazonSqs.QueueUrl = pluginConfigString(plugin, "queue_url")
+		amazonSqs.Region = pluginConfigString(plugin, "region")
+		amazonSqs.AccessKey = pluginConfigString(plugin, "access_key")
+		amazonSqs.MessageGroupId = pluginConfigString(plugin, "message_group_id")
+		amazonSqs.S3Bucket = pluginConfigString(plugin, "s3_bucket")
+		m.AmazonSqs = amazonSqs
+	case dataForwardingPluginWebhooks:
+		urls := splitCustomFilter(plugin.ConfigValue("urls"))
+		m.Webhooks = &ProjectDataForwardingWebhooksModel{
+			Urls: types.SetValueMust(types.StringType, stringsToValues(urls)),
+		}
+	default:
+		return fmt.Errorf("unsupported data forwarding plugin: %s", plugin.ID)
+	}
+
+	return nil
+}
+
+// plugin returns the ID of the configured forwarder and its config.
+func (m ProjectDataForwardingResourceModel) plugin(ctx context.Context) (string, sentry.UpdateProjectPluginParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case m.Segment != nil:
+		return dataForwardingPluginSegment, sentry.UpdateProjectPluginParams{
+			"write_key": m.Segment.WriteKey.ValueString(),
+		}, diags
+	case m.Splunk != nil:
+		return dataForwardingPluginSplunk, sentry.UpdateProjectPluginParams{
+			"instance": m.Splunk.Instance.ValueString(),
+			"index":    m.Splunk.Index.ValueString(),
+			"source":   m.Splunk.Source.ValueString(),
+			"token":    m.Splunk.Token.ValueString(),
+		}, diags
+	case m.AmazonSqs != nil:
+		return dataForwardingPluginAmazonSqs, sentry.UpdateProjectPluginParams{
+			"queue_url":        m.AmazonSqs.QueueUrl.ValueString(),
+			"region":           m.AmazonSqs.Region.ValueString(),
+			"access_key":       m.AmazonSqs.AccessKey.ValueString(),
+			"secret_key":       m.AmazonSqs.SecretKey.ValueString(),
+			"message_group_id": m.AmazonSqs.MessageGroupId.ValueString(),
+			"s3_bucket":        m.AmazonSqs.S3Bucket.ValueString(),
+		}, diags
+	case m.Webhooks != nil:
+		urls, d := joinCustomFilter(ctx, m.Webhooks.Urls)
+		diags.Append(d...)
+		return dataForwardingPluginWebhooks, sentry.UpdateProjectPluginParams{
+			"urls": urls,
+		}, diags
+	}
+
+	diags.AddError("Invalid configuration", "No data forwarder is configured")
+	return "", nil, diags
+}
+
+func (r *ProjectDataForwardingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_data_forwarding"
+}
+
+func (r *ProjectDataForwardingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("segment"),
+			path.MatchRoot("splunk"),
+			path.MatchRoot("amazon_sqs"),
+			path.MatchRoot("webhooks"),
+		),
+	}
+}
+
+// requiresReplaceIfForwarderChanges replaces the resource when a forwarder is
+// added or removed, i.e. when the resource switches to another plugin.
+func requiresReplaceIfForwarderChanges() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+		},
+		"Switching to another data forwarder requires replacement.",
+		"Switching to another data forwarder requires replacement.",
+	)
+}
+
+func (r *ProjectDataForwardingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Data Forwarding resource. Configures one of the data forwarding plugins of a project: Segment, Splunk, Amazon SQS or webhooks. Exactly one of `segment`, `splunk`, `amazon_sqs` or `webhooks` must be set.\n\n" +
+			"Secret fields are never read back from Sentry, which masks them, so changes made outside of Terraform are not detected. Destroying the resource disables the plugin.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether events are forwarded. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"segment": schema.SingleNestedAttribute{
+				MarkdownDescription: "Forward events to [Segment](https://segment.com/).",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"write_key": schema.StringAttribute{
+						MarkdownDescription: "The write key of the Segment source.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceIfForwarderChanges(),
+				},
+			},
+			"splunk": schema.SingleNestedAttribute{
+				MarkdownDescription: "Forward events to a [Splunk](https://www.splunk.com/) HTTP Event Collector.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"instance": schema.StringAttribute{
+						MarkdownDescription: "The URL of the HTTP Event Collector, e.g. `https://input-foo.cloud.splunk.com:8088`.",
+						Required:            true,
+					},
+					"index": schema.StringAttribute{
+						MarkdownDescription: "The Splunk index the events are written to.",
+						Required:            true,
+					},
+					"source": schema.StringAttribute{
+						MarkdownDescription: "The source of the events. Defaults to `sentry` in Sentry.",
+						Optional:            true,
+					},
+					"token": schema.StringAttribute{
+						MarkdownDescription: "The HTTP Event Collector token.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceIfForwarderChanges(),
+				},
+			},
+			"amazon_sqs": schema.SingleNestedAttribute{
+				MarkdownDescription: "Forward events to an [Amazon SQS](https://aws.amazon.com/sqs/) queue.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"queue_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the queue.",
+						Required:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The AWS region of the queue.",
+						Required:            true,
+					},
+					"access_key": schema.StringAttribute{
+						MarkdownDescription: "The AWS access key ID.",
+						Required:            true,
+					},
+					"secret_key": schema.StringAttribute{
+						MarkdownDescription: "The AWS secret access key.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"message_group_id": schema.StringAttribute{
+						MarkdownDescription: "The message group ID. Required for FIFO queues.",
+						Optional:            true,
+					},
+					"s3_bucket": schema.StringAttribute{
+						MarkdownDescription: "An S3 bucket that large events are written to instead, with the S3 key sent to the queue.",
+						Optional:            true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceIfForwarderChanges(),
+				},
+			},
+			"webhooks": schema.SingleNestedAttribute{
+				MarkdownDescription: "Forward events to one or more URLs.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"urls": schema.SetAttribute{
+						MarkdownDescription: "The URLs the events are posted to.",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceIfForwarderChanges(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectDataForwardingResource) update(ctx context.Context, data *ProjectDataForwardingResourceModel, wasEnabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pluginId, params, d := data.plugin(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	organization, project := data.Organization.ValueString(), data.Project.ValueString()
+
+	if data.Enabled.ValueBool() && !wasEnabled {
+		if _, err := r.client.ProjectPlugins.Enable(ctx, organization, project, pluginId); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Error enabling data forwarding: %s", err.Error()))
+			return diags
+		}
+	}
+
+	if _, _, err := r.client.ProjectPlugins.Update(ctx, organization, project, pluginId, params); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error updating data forwarding: %s", err.Error()))
+		return diags
+	}
+
+	if !data.Enabled.ValueBool() && wasEnabled {
+		if _, err := r.client.ProjectPlugins.Disable(ctx, organization, project, pluginId); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Error disabling data forwarding: %s", err.Error()))
+			return diags
+		}
+	}
+
+	plugin, _, err := sentryclient.GetProjectPlugin(ctx, r.client, organization, project, pluginId)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading data forwarding: %s", err.Error()))
+		return diags
+	}
+
+	if err := data.Fill(organization, project, *plugin); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return diags
+	}
+
+	return diags
+}
+
+func (r *ProjectDataForwardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectDataForwardingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The plugin may already be enabled outside of Terraform, in which case
+	// it is disabled again if it should not be.
+	resp.Diagnostics.Append(r.update(ctx, &data, !data.Enabled.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectDataForwardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectDataForwardingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, project, pluginId, err := splitThreePartID(data.Id.ValueString(), "organization", "project-slug", "plugin")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+
+	plugin, apiResp, err := sentryclient.GetProjectPlugin(ctx, r.client, organization, project, pluginId)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Data forwarding plugin not found: %s", pluginId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading data forwarding: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(organization, project, *plugin); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectDataForwardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ProjectDataForwardingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data, state.Enabled.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectDataForwardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectDataForwardingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pluginId, _, diags := data.plugin(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.ProjectPlugins.Disable(ctx, data.Organization.ValueString(), data.Project.ValueString(), pluginId)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling data forwarding: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectDataForwardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, pluginId, err := splitThreePartID(req.ID, "organization", "project-slug", "plugin")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+
+	switch pluginId {
+	case dataForwardingPluginSegment, dataForwardingPluginSplunk, dataForwardingPluginAmazonSqs, dataForwardingPluginWebhooks:
+	default:
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unsupported data forwarding plugin %q, expected one of %q, %q, %q or %q", pluginId, dataForwardingPluginSegment, dataForwardingPluginSplunk, dataForwardingPluginAmazonSqs, dataForwardingPluginWebhooks))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestProjectDataForwardingResourceModelFill(t *testing.T) {
+	t.Parallel()
+
+	data := ProjectDataForwardingResourceModel{
+		Splunk: &ProjectDataForwardingSplunkModel{
+			Token: types.StringValue("my-token"),
+		},
+	}
+	err := data.Fill("my-org", "my-project", sentryclient.ProjectPlugin{
+		ProjectPlugin: sentry.ProjectPlugin{
+			ID: "splunk",
+			Config: []sentry.ProjectPluginConfig{
+				{Name: "instance", Value: "https://splunk.example.com:8088"},
+				{Name: "index", Value: "main"},
+				{Name: "source", Value: ""},
+				{Name: "token", Type: "secret", Value: "my-t************"},
+			},
+		},
+		Enabled: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The masked token is ignored.
+	expected := &ProjectDataForwardingSplunkModel{
+		Instance: types.StringValue("https://splunk.example.com:8088"),
+		Index:    types.StringValue("main"),
+		Source:   types.StringNull(),
+		Token:    types.StringValue("my-token"),
+	}
+	if diff := cmp.Diff(expected, data.Splunk); diff != "" {
+		t.Errorf("unexpected splunk config (-want +got):\n%s", diff)
+	}
+	if data.Id.ValueString() != "my-org/my-project/splunk" {
+		t.Errorf("unexpected id: %s", data.Id)
+	}
+	if !data.Enabled.ValueBool() {
+		t.Error("expected the plugin to be enabled")
+	}
+	if data.Segment != nil || data.AmazonSqs != nil || data.Webhooks != nil {
+		t.Error("expected the other forwarders to be unset")
+	}
+
+	if err := data.Fill("my-org", "my-project", sentryclient.ProjectPlugin{ProjectPlugin: sentry.ProjectPlugin{ID: "github"}}); err == nil {
+		t.Error("expected an error for an unsupported plugin")
+	}
+}
+
+func TestAccProjectDataForwardingResource(t *testing.T) {
+	rn := "sentry_project_data_forwarding.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_data_forwarding" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	webhooks = {
+		urls = ["https://example.com/a", "https://example.com/b"]
+	}
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(acctest.TestOrganization+"/"+project+"/webhooks")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("webhooks").AtMapKey("urls"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("https://example.com/a"),
+						knownvalue.StringExact("https://example.com/b"),
+					})),
+				},
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_data_forwarding" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	enabled      = false
+
+	webhooks = {
+		urls = ["https://example.com/a"]
+	}
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("webhooks").AtMapKey("urls"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("https://example.com/a"),
+					})),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_data_forwarding" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	segment = {
+		write_key = "test-write-key"
+	}
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(acctest.TestOrganization+"/"+project+"/segment")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("segment").AtMapKey("write_key"), knownvalue.StringExact("test-write-key")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("webhooks"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectDataScrubbingResource{}
+var _ resource.ResourceWithConfigure = &ProjectDataScrubbingResource{}
+var _ resource.ResourceWithImportState = &ProjectDataScrubbingResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectDataScrubbingResource{}
+
+func NewProjectDataScrubbingResource() resource.Resource {
+	return &ProjectDataScrubbingResource{}
+}
+
+type ProjectDataScrubbingResource struct {
+	baseResource
+}
+
+type ProjectDataScrubbingResourceModel struct {
+	Id           types.String             `tfsdk:"id"`
+	Organization types.String             `tfsdk:"organization"`
+	Project      types.String             `tfsdk:"project"`
+	Rules        []DataScrubbingRuleModel `tfsdk:"rules"`
+}
+
+func (m *ProjectDataScrubbingResourceModel) Fill(organization string, project string, relayPiiConfig *string) error {
+	m.Id = types.StringValue(buildTwoPartID(organization, project))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+
+	rules, err := parseRelayPiiConfig(sentry.StringValue(relayPiiConfig))
+	if err != nil {
+		return err
+	}
+	m.Rules = rules
+
+	return nil
+}
+
+func (r *ProjectDataScrubbingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_data_scrubbing"
+}
+
+func (r *ProjectDataScrubbingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Data Scrubbing resource. Manages the advanced data scrubbing rules that Relay applies to the events of a project before they are stored.\n\n" +
+			"This resource is authoritative for the rule types it supports: it replaces any such rules added in the Sentry UI, and destroying it removes them. Rules of other types, e.g. `redact_pair`, are left unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": dataScrubbingRulesAttribute(),
+		},
+	}
+}
+
+func (r *ProjectDataScrubbingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateDataScrubbingRules(ctx, req.Config)...)
+}
+
+func (r *ProjectDataScrubbingResource) update(ctx context.Context, data *ProjectDataScrubbingResourceModel) error {
+	current, _, err := sentryclient.GetProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if err != nil {
+		return err
+	}
+
+	relayPiiConfig, err := buildRelayPiiConfig(data.Rules, sentry.StringValue(current.RelayPiiConfig))
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "Updating project data scrubbing rules", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Project.ValueString(),
+		"rules":   len(data.Rules),
+	})
+	options, _, err := sentryclient.UpdateProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.ProjectOptionsParams{
+		RelayPiiConfig: sentry.String(relayPiiConfig),
+	})
+	if err != nil {
+		return err
+	}
+
+	return data.Fill(data.Organization.ValueString(), data.Project.ValueString(), options.RelayPiiConfig)
+}
+
+func (r *ProjectDataScrubbingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating project data scrubbing rules: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectDataScrubbingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, apiResp, err := sentryclient.GetProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Project.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(unsupportedRelayPiiRuleWarnings(sentry.StringValue(options.RelayPiiConfig))...)
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), options.RelayPiiConfig); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectDataScrubbingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating project data scrubbing rules: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectDataScrubbingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectDataScrubbingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, apiResp, err := sentryclient.GetProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project: %s", err.Error()))
+		return
+	}
+
+	relayPiiConfig, err := buildRelayPiiConfig(nil, sentry.StringValue(current.RelayPiiConfig))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing project data scrubbing rules: %s", err.Error()))
+		return
+	}
+
+	_, apiResp, err = sentryclient.UpdateProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.ProjectOptionsParams{
+		RelayPiiConfig: sentry.String(relayPiiConfig),
+	})
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing project data scrubbing rules: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectDataScrubbingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, err := splitTwoPartID(req.ID, "organization", "project-slug")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccProjectDataScrubbingResource(t *testing.T) {
+	rn := "sentry_project_data_scrubbing.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_data_scrubbing" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	rules = [
+		{
+			method = "mask"
+			type   = "creditcard"
+			source = "$string"
+		},
+		{
+			method      = "replace"
+			type        = "pattern"
+			pattern     = "secret-\\d+"
+			replacement = "[secret]"
+			source      = "$http.headers.x-custom-token"
+		},
+	]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(fmt.Sprintf("%s/%s", acctest.TestOrganization, project))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"method":      knownvalue.StringExact("mask"),
+							"type":        knownvalue.StringExact("creditcard"),
+							"source":      knownvalue.StringExact("$string"),
+							"pattern":     knownvalue.Null(),
+							"replacement": knownvalue.Null(),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"method":      knownvalue.StringExact("replace"),
+							"type":        knownvalue.StringExact("pattern"),
+							"source":      knownvalue.StringExact("$http.headers.x-custom-token"),
+							"pattern":     knownvalue.StringExact(`secret-\d+`),
+							"replacement": knownvalue.StringExact("[secret]"),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_data_scrubbing" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	rules = [
+		{
+			method = "remove"
+			type   = "pattern"
+			source = "$string &&"
+		},
+	]
+}
+`,
+				ExpectError: regexp.MustCompile(`not a valid data scrubbing selector`),
+			},
+		},
+	})
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectEnvironmentResource{}
+var _ resource.ResourceWithConfigure = &ProjectEnvironmentResource{}
+var _ resource.ResourceWithImportState = &ProjectEnvironmentResource{}
+
+func NewProjectEnvironmentResource() resource.Resource {
+	return &ProjectEnvironmentResource{}
+}
+
+type ProjectEnvironmentResource struct {
+	baseResource
+}
+
+type ProjectEnvironmentResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Name         types.String `tfsdk:"name"`
+	IsHidden     types.Bool   `tfsdk:"is_hidden"`
+}
+
+func (m *ProjectEnvironmentResourceModel) Fill(organization string, project string, environment sentryclient.ProjectEnvironment) error {
+	m.Id = types.StringValue(buildThreePartID(organization, project, environment.Name))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Name = types.StringValue(environment.Name)
+	m.IsHidden = types.BoolValue(environment.IsHidden)
+
+	return nil
+}
+
+func (r *ProjectEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environment"
+}
+
+func (r *ProjectEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Environment resource. Hides or shows an environment of a project in the environment selectors of Sentry.\n\n" +
+			"Sentry creates environments when it receives their first event, so the environment must exist already. Destroying the resource makes the environment visible again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_hidden": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment is hidden.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectEnvironmentResource) update(ctx context.Context, data *ProjectEnvironmentResourceModel) error {
+	tflog.Debug(ctx, "Updating project environment", map[string]interface{}{
+		"org":         data.Organization.ValueString(),
+		"project":     data.Project.ValueString(),
+		"environment": data.Name.ValueString(),
+		"isHidden":    data.IsHidden.ValueBool(),
+	})
+	environment, apiResp, err := sentryclient.UpdateProjectEnvironment(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Name.ValueString(), &sentryclient.UpdateProjectEnvironmentParams{
+		IsHidden: data.IsHidden.ValueBool(),
+	})
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("environment %q not found in project %s, environments are created when Sentry receives their first event", data.Name.ValueString(), data.Project.ValueString())
+	}
+	if err != nil {
+		return err
+	}
+
+	return data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *environment)
+}
+
+func (r *ProjectEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating project environment: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, apiResp, err := sentryclient.GetProjectEnvironment(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Name.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project environment not found: %s", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project environment: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *environment); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating project environment: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, apiResp, err := sentryclient.UpdateProjectEnvironment(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Name.ValueString(), &sentryclient.UpdateProjectEnvironmentParams{
+		IsHidden: false,
+	})
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error showing project environment: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, name, err := splitThreePartID(req.ID, "organization", "project-slug", "environment-name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("name"), name,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
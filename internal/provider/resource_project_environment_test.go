@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestAccProjectEnvironmentResource(t *testing.T) {
+	acctest.PreCheck(t)
+
+	// Sentry only creates environments when it receives their first event, so
+	// use a visible environment of a project that already exists.
+	ctx := context.Background()
+	projects, _, err := acctest.SharedClient.OrganizationProjects.List(ctx, acctest.TestOrganization, &sentry.ListOrganizationProjectsParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var project, environment string
+	for _, p := range projects {
+		environments, _, err := sentryclient.ListProjectEnvironments(ctx, acctest.SharedClient, acctest.TestOrganization, p.Slug, "visible")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(environments) > 0 {
+			project, environment = p.Slug, environments[0].Name
+			break
+		}
+	}
+	if project == "" {
+		t.Skip("no project with a visible environment in the test organization")
+	}
+
+	rn := "sentry_project_environment.test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(buildThreePartID(acctest.TestOrganization, project, environment))),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(environment)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectEnvironmentResourceConfig(project, environment, true),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("is_hidden"), knownvalue.Bool(true)),
+				),
+			},
+			{
+				Config: testAccProjectEnvironmentResourceConfig(project, environment, false),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("is_hidden"), knownvalue.Bool(false)),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentResourceConfig(project string, environment string, isHidden bool) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project_environment" "test" {
+	organization = data.sentry_organization.test.id
+	project      = "%[1]s"
+	name         = "%[2]s"
+	is_hidden    = %[3]t
+}
+`, project, environment, isHidden)
+}
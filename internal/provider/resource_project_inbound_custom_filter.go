@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectInboundCustomFilterResource{}
+var _ resource.ResourceWithConfigure = &ProjectInboundCustomFilterResource{}
+var _ resource.ResourceWithImportState = &ProjectInboundCustomFilterResource{}
+
+func NewProjectInboundCustomFilterResource() resource.Resource {
+	return &ProjectInboundCustomFilterResource{}
+}
+
+type ProjectInboundCustomFilterResource struct {
+	baseResource
+}
+
+type ProjectInboundCustomFilterResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Organization   types.String `tfsdk:"organization"`
+	Project        types.String `tfsdk:"project"`
+	ErrorMessages  types.Set    `tfsdk:"error_messages"`
+	Releases       types.Set    `tfsdk:"releases"`
+	BlacklistedIps types.Set    `tfsdk:"blacklisted_ips"`
+}
+
+func (m *ProjectInboundCustomFilterResourceModel) Fill(organization string, project string, options map[string]interface{}) error {
+	m.Id = types.StringValue(buildTwoPartID(organization, project))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+
+	var err error
+	if m.ErrorMessages, err = customFilterSet(options, sentryclient.ProjectOptionFilterErrorMessages); err != nil {
+		return err
+	}
+	if m.Releases, err = customFilterSet(options, sentryclient.ProjectOptionFilterReleases); err != nil {
+		return err
+	}
+	if m.BlacklistedIps, err = customFilterSet(options, sentryclient.ProjectOptionFilterBlacklistedIPs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// customFilterSet reads a newline-separated custom filter option into a set,
+// ignoring blank lines. An empty filter is null.
+func customFilterSet(options map[string]interface{}, key string) (types.Set, error) {
+	value, ok := options[key]
+	if !ok || value == nil {
+		return types.SetNull(types.StringType), nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return types.SetNull(types.StringType), fmt.Errorf("unexpected type %T for project option %s", value, key)
+	}
+
+	entries := splitCustomFilter(s)
+	if len(entries) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueMust(types.StringType, stringsToValues(entries)), nil
+}
+
+func splitCustomFilter(s string) []string {
+	entries := []string{}
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			entries = append(entries, line)
+		}
+	}
+	return entries
+}
+
+// joinCustomFilter renders a set as a custom filter option. The entries are
+// sorted so that the option does not change between applies.
+func joinCustomFilter(ctx context.Context, set types.Set) (string, diag.Diagnostics) {
+	if set.IsNull() {
+		return "", nil
+	}
+
+	var entries []string
+	diags := set.ElementsAs(ctx, &entries, false)
+	slices.Sort(entries)
+	return strings.Join(entries, "\n"), diags
+}
+
+func (r *ProjectInboundCustomFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_inbound_custom_filter"
+}
+
+func (r *ProjectInboundCustomFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Inbound Custom Filter resource. Manages the custom inbound filters of a project, which discard events by error message, release or IP address before they count towards the quota. Use `sentry_project_inbound_data_filter` for the built-in filters.\n\n" +
+			"This resource is authoritative for the three filters: a filter that is not configured is cleared, and destroying the resource clears all of them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"error_messages": schema.SetAttribute{
+				MarkdownDescription: "Discard events whose error message matches one of these glob patterns, e.g. `TypeError: *`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"releases": schema.SetAttribute{
+				MarkdownDescription: "Discard events from releases that match one of these glob patterns, e.g. `1.*`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(globValidator{}),
+				},
+			},
+			"blacklisted_ips": schema.SetAttribute{
+				MarkdownDescription: "Discard events from these IP addresses or CIDR ranges, e.g. `10.0.0.0/8`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(ipOrCidrValidator{}),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectInboundCustomFilterResource) update(ctx context.Context, data *ProjectInboundCustomFilterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	errorMessages, d := joinCustomFilter(ctx, data.ErrorMessages)
+	diags.Append(d...)
+	releases, d := joinCustomFilter(ctx, data.Releases)
+	diags.Append(d...)
+	blacklistedIps, d := joinCustomFilter(ctx, data.BlacklistedIps)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Updating project inbound custom filters", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Project.ValueString(),
+	})
+	options, _, err := sentryclient.UpdateProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.ProjectOptionsParams{
+		Options: map[string]interface{}{
+			sentryclient.ProjectOptionFilterErrorMessages:  errorMessages,
+			sentryclient.ProjectOptionFilterReleases:       releases,
+			sentryclient.ProjectOptionFilterBlacklistedIPs: blacklistedIps,
+		},
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error updating project inbound custom filters: %s", err.Error()))
+		return diags
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), options.Options); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+	}
+	return diags
+}
+
+func (r *ProjectInboundCustomFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectInboundCustomFilterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectInboundCustomFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectInboundCustomFilterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, apiResp, err := sentryclient.GetProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Project.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), options.Options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectInboundCustomFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectInboundCustomFilterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectInboundCustomFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectInboundCustomFilterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, apiResp, err := sentryclient.UpdateProjectOptions(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.ProjectOptionsParams{
+		Options: map[string]interface{}{
+			sentryclient.ProjectOptionFilterErrorMessages:  "",
+			sentryclient.ProjectOptionFilterReleases:       "",
+			sentryclient.ProjectOptionFilterBlacklistedIPs: "",
+		},
+	})
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error clearing project inbound custom filters: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectInboundCustomFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, err := splitTwoPartID(req.ID, "organization", "project-slug")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
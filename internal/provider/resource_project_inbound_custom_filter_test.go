@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestCustomFilterSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options  map[string]interface{}
+		expected types.Set
+	}{
+		"lines": {
+			options:  map[string]interface{}{"filters:releases": "1.*\n\n 0.9.0-beta \n"},
+			expected: types.SetValueMust(types.StringType, stringsToValues([]string{"0.9.0-beta", "1.*"})),
+		},
+		"empty": {
+			options:  map[string]interface{}{"filters:releases": ""},
+			expected: types.SetNull(types.StringType),
+		},
+		"missing": {
+			options:  map[string]interface{}{},
+			expected: types.SetNull(types.StringType),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			set, err := customFilterSet(tc.options, "filters:releases")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !set.Equal(tc.expected) {
+				t.Errorf("expected %s, got %s", tc.expected, set)
+			}
+		})
+	}
+}
+
+func TestAccProjectInboundCustomFilterResource(t *testing.T) {
+	rn := "sentry_project_inbound_custom_filter.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_inbound_custom_filter" "test" {
+	organization    = sentry_project.test.organization
+	project         = sentry_project.test.id
+	error_messages  = ["TypeError: *", "ChunkLoadError*"]
+	releases        = ["1.*", "0.9.0-beta"]
+	blacklisted_ips = ["127.0.0.1", "10.0.0.0/8"]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(fmt.Sprintf("%s/%s", acctest.TestOrganization, project))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("error_messages"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("TypeError: *"),
+						knownvalue.StringExact("ChunkLoadError*"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("releases"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("1.*"),
+						knownvalue.StringExact("0.9.0-beta"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("blacklisted_ips"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("127.0.0.1"),
+						knownvalue.StringExact("10.0.0.0/8"),
+					})),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_inbound_custom_filter" "test" {
+	organization    = sentry_project.test.organization
+	project         = sentry_project.test.id
+	blacklisted_ips = ["10.0.0.0/8"]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("error_messages"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("releases"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("blacklisted_ips"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("10.0.0.0/8"),
+					})),
+				},
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_inbound_custom_filter" "test" {
+	organization    = sentry_project.test.organization
+	project         = sentry_project.test.id
+	blacklisted_ips = ["10.0.0.300"]
+}
+`,
+				ExpectError: regexp.MustCompile(`not an IP address or a CIDR range`),
+			},
+		},
+	})
+}
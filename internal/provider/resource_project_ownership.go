@@ -0,0 +1,484 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectOwnershipResource{}
+var _ resource.ResourceWithConfigure = &ProjectOwnershipResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectOwnershipResource{}
+var _ resource.ResourceWithImportState = &ProjectOwnershipResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectOwnershipResource{}
+
+var projectOwnershipAutoAssignments = []string{
+	"Auto Assign to Issue Owner",
+	"Auto Assign to Suspect Commits",
+	"Turn off Auto-Assignment",
+}
+
+func NewProjectOwnershipResource() resource.Resource {
+	return &ProjectOwnershipResource{}
+}
+
+type ProjectOwnershipResource struct {
+	baseResource
+}
+
+type ProjectOwnershipRuleModel struct {
+	Type    types.String   `tfsdk:"type"`
+	Pattern types.String   `tfsdk:"pattern"`
+	Owners  []types.String `tfsdk:"owners"`
+}
+
+type ProjectOwnershipResourceModel struct {
+	Id                 types.String                `tfsdk:"id"`
+	Organization       types.String                `tfsdk:"organization"`
+	Project            types.String                `tfsdk:"project"`
+	Raw                types.String                `tfsdk:"raw"`
+	Rules              []ProjectOwnershipRuleModel `tfsdk:"rules"`
+	Fallthrough        types.Bool                  `tfsdk:"fallthrough"`
+	AutoAssignment     types.String                `tfsdk:"auto_assignment"`
+	CodeownersAutoSync types.Bool                  `tfsdk:"codeowners_auto_sync"`
+}
+
+func (m *ProjectOwnershipResourceModel) Fill(organization string, project string, ownership sentry.ProjectOwnership) error {
+	m.Id = types.StringValue(buildTwoPartID(organization, project))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Raw = types.StringValue(ownership.Raw)
+	m.Fallthrough = types.BoolValue(ownership.FallThrough)
+	m.AutoAssignment = types.StringValue(ownership.AutoAssignment)
+	m.CodeownersAutoSync = types.BoolPointerValue(ownership.CodeownersAutoSync)
+
+	return nil
+}
+
+// ownershipRule is a single line of the ownership rules text format, e.g.
+// `path:src/payments/* #payments jane@example.com`.
+type ownershipRule struct {
+	Type    string
+	Pattern string
+	Owners  []string
+}
+
+func renderOwnershipRules(rules []ownershipRule) string {
+	lines := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		lines = append(lines, strings.Join(append([]string{rule.Type + ":" + rule.Pattern}, rule.Owners...), " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func parseOwnershipRules(raw string) ([]ownershipRule, error) {
+	var rules []ownershipRule
+	for i, line := range strings.Split(raw, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		ruleType, pattern, ok := strings.Cut(fields[0], ":")
+		if !ok || ruleType == "" || pattern == "" {
+			return nil, fmt.Errorf("line %d: expected a rule of the form `type:pattern owners...`, got %q", i+1, line)
+		}
+		rules = append(rules, ownershipRule{
+			Type:    ruleType,
+			Pattern: pattern,
+			Owners:  fields[1:],
+		})
+	}
+	return rules, nil
+}
+
+// rulesToOwnership converts the structured rules to the text format. It returns
+// false if any value is not known yet.
+func (m ProjectOwnershipResourceModel) rulesToOwnership() ([]ownershipRule, bool) {
+	rules := make([]ownershipRule, 0, len(m.Rules))
+	for _, rule := range m.Rules {
+		if rule.Type.IsUnknown() || rule.Pattern.IsUnknown() {
+			return nil, false
+		}
+		owners := make([]string, 0, len(rule.Owners))
+		for _, owner := range rule.Owners {
+			if owner.IsUnknown() {
+				return nil, false
+			}
+			owners = append(owners, owner.ValueString())
+		}
+		rules = append(rules, ownershipRule{
+			Type:    rule.Type.ValueString(),
+			Pattern: rule.Pattern.ValueString(),
+			Owners:  owners,
+		})
+	}
+	return rules, true
+}
+
+func (r *ProjectOwnershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_ownership"
+}
+
+func (r *ProjectOwnershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Ownership resource. Manages the issue ownership rules and auto-assignment settings of a project.\n\n" +
+			"The rules are set either as `raw` text in [Sentry's ownership rules format](https://docs.sentry.io/product/issues/ownership-rules/), or as a list of structured `rules` that is rendered to that format. " +
+			"Owners are validated against the teams and members of the organization during plan. Destroying the resource clears the rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"raw": schema.StringAttribute{
+				MarkdownDescription: "The ownership rules in Sentry's text format, one rule per line, e.g. `path:src/payments/* #payments`. Conflicts with `rules`, and is computed from it when `rules` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The ownership rules, in order. Conflicts with `raw`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The matcher type: `path`, `module`, `url`, `codeowners`, or `tags.<key>` to match the value of a tag.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^(path|module|url|codeowners|tags\.[^\s:]+)$`),
+									"must be one of `path`, `module`, `url`, `codeowners` or `tags.<key>`",
+								),
+							},
+						},
+						"pattern": schema.StringAttribute{
+							MarkdownDescription: "The pattern to match, e.g. `src/payments/*`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^\S+$`), "must not be empty or contain whitespace"),
+							},
+						},
+						"owners": schema.ListAttribute{
+							MarkdownDescription: "The owners of the matching issues: `#team-slug` for a team, or the email address of a member.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"fallthrough": schema.BoolAttribute{
+				MarkdownDescription: "Whether all users with access to the project are notified when no rule matches.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_assignment": schema.StringAttribute{
+				MarkdownDescription: "How issues are assigned automatically. One of `" + strings.Join(projectOwnershipAutoAssignments, "`, `") + "`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectOwnershipAutoAssignments...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"codeowners_auto_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether CODEOWNERS files are synced automatically when a new commit is made.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectOwnershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("raw"),
+			path.MatchRoot("rules"),
+		),
+	}
+}
+
+// ModifyPlan renders the structured rules to the text format, so that changes
+// made outside of Terraform show up as a diff of `raw`, and validates the
+// owners against the teams and members of the organization.
+func (r *ProjectOwnershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectOwnershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []ownershipRule
+	if plan.Rules != nil {
+		var known bool
+		rules, known = plan.rulesToOwnership()
+		if !known {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("raw"), types.StringUnknown())...)
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("raw"), renderOwnershipRules(rules))...)
+	} else {
+		var raw types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raw"), &raw)...)
+		if raw.IsNull() || raw.IsUnknown() {
+			return
+		}
+
+		var err error
+		rules, err = parseOwnershipRules(raw.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("raw"), "Invalid Ownership Rules", err.Error())
+			return
+		}
+	}
+
+	if r.client == nil || plan.Organization.IsUnknown() || len(rules) == 0 {
+		return
+	}
+	resp.Diagnostics.Append(r.validateOwners(ctx, plan.Organization.ValueString(), rules)...)
+}
+
+// validateOwners checks that every owner is an existing team or member.
+func (r *ProjectOwnershipResource) validateOwners(ctx context.Context, organization string, rules []ownershipRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var teams, emails []string
+	teamsParams := &sentry.ListCursorParams{}
+	for {
+		page, apiResp, err := r.client.Teams.List(ctx, organization, teamsParams)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Error listing teams: %s", err.Error()))
+			return diags
+		}
+		for _, team := range page {
+			teams = append(teams, sentry.StringValue(team.Slug))
+		}
+		if apiResp.Cursor == "" {
+			break
+		}
+		teamsParams.Cursor = apiResp.Cursor
+	}
+
+	membersParams := &sentry.ListCursorParams{}
+	for {
+		page, apiResp, err := r.client.OrganizationMembers.List(ctx, organization, membersParams)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Error listing organization members: %s", err.Error()))
+			return diags
+		}
+		for _, member := range page {
+			emails = append(emails, strings.ToLower(member.Email), strings.ToLower(member.User.Email))
+		}
+		if apiResp.Cursor == "" {
+			break
+		}
+		membersParams.Cursor = apiResp.Cursor
+	}
+
+	for _, rule := range rules {
+		for _, owner := range rule.Owners {
+			switch {
+			case strings.HasPrefix(owner, "#"):
+				if !slices.Contains(teams, strings.TrimPrefix(owner, "#")) {
+					diags.AddError("Invalid Owner", fmt.Sprintf("Team %s of rule `%s:%s` does not exist in organization %s.", owner, rule.Type, rule.Pattern, organization))
+				}
+			case strings.Contains(owner, "@"):
+				if !slices.Contains(emails, strings.ToLower(owner)) {
+					diags.AddError("Invalid Owner", fmt.Sprintf("Member %s of rule `%s:%s` does not exist in organization %s.", owner, rule.Type, rule.Pattern, organization))
+				}
+			default:
+				diags.AddError("Invalid Owner", fmt.Sprintf("Owner %q of rule `%s:%s` must be a team in the form `#team-slug` or the email address of a member.", owner, rule.Type, rule.Pattern))
+			}
+		}
+	}
+
+	return diags
+}
+
+func (r *ProjectOwnershipResource) update(ctx context.Context, data *ProjectOwnershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := &sentryclient.UpdateProjectOwnershipParams{}
+	if !data.Raw.IsUnknown() {
+		params.Raw = data.Raw.ValueStringPointer()
+	}
+	if !data.Fallthrough.IsUnknown() {
+		params.FallThrough = data.Fallthrough.ValueBoolPointer()
+	}
+	if !data.AutoAssignment.IsUnknown() {
+		params.AutoAssignment = data.AutoAssignment.ValueStringPointer()
+	}
+	if !data.CodeownersAutoSync.IsUnknown() {
+		params.CodeownersAutoSync = data.CodeownersAutoSync.ValueBoolPointer()
+	}
+
+	tflog.Debug(ctx, "Updating project ownership", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Project.ValueString(),
+	})
+	ownership, _, err := sentryclient.UpdateProjectOwnership(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), params)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error updating project ownership: %s", err.Error()))
+		return diags
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *ownership); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+	}
+	return diags
+}
+
+func (r *ProjectOwnershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectOwnershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectOwnershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectOwnershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownership, apiResp, err := r.client.ProjectOwnerships.Get(ctx, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Project.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project ownership: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *ownership); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectOwnershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectOwnershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectOwnershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectOwnershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Clearing project ownership rules", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Project.ValueString(),
+	})
+	_, apiResp, err := sentryclient.UpdateProjectOwnership(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.UpdateProjectOwnershipParams{
+		Raw: sentry.String(""),
+	})
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error clearing project ownership: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectOwnershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, err := splitTwoPartID(req.ID, "organization", "project-slug")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestOwnershipRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw           string
+		expected      []ownershipRule
+		expectedRaw   string
+		expectedError bool
+	}{
+		"rules": {
+			raw: "path:src/payments/* #payments jane@example.com\n\n# comment\ntags.sku_class:enterprise  #enterprise\n",
+			expected: []ownershipRule{
+				{Type: "path", Pattern: "src/payments/*", Owners: []string{"#payments", "jane@example.com"}},
+				{Type: "tags.sku_class", Pattern: "enterprise", Owners: []string{"#enterprise"}},
+			},
+			expectedRaw: "path:src/payments/* #payments jane@example.com\ntags.sku_class:enterprise #enterprise",
+		},
+		"url with scheme": {
+			raw: "url:https://example.com/checkout/* #checkout",
+			expected: []ownershipRule{
+				{Type: "url", Pattern: "https://example.com/checkout/*", Owners: []string{"#checkout"}},
+			},
+			expectedRaw: "url:https://example.com/checkout/* #checkout",
+		},
+		"empty": {
+			raw:         "",
+			expectedRaw: "",
+		},
+		"missing type": {
+			raw:           "src/payments/* #payments",
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := parseOwnershipRules(tc.raw)
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, rules); diff != "" {
+				t.Errorf("unexpected rules (-want +got):\n%s", diff)
+			}
+			if raw := renderOwnershipRules(rules); raw != tc.expectedRaw {
+				t.Errorf("expected raw %q, got %q", tc.expectedRaw, raw)
+			}
+		})
+	}
+}
+
+func TestAccProjectOwnershipResource(t *testing.T) {
+	rn := "sentry_project_ownership.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Owners are validated at plan time, so the team has to exist
+				// first.
+				Config: testAccProjectResourceConfig(team, project),
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_ownership" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	rules = [
+		{
+			type    = "path"
+			pattern = "src/payments/*"
+			owners  = ["#${sentry_team.test.slug}"]
+		},
+		{
+			type    = "tags.sku_class"
+			pattern = "enterprise"
+			owners  = ["#${sentry_team.test.slug}"]
+		},
+	]
+
+	fallthrough     = false
+	auto_assignment = "Auto Assign to Suspect Commits"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(fmt.Sprintf("%s/%s", acctest.TestOrganization, project))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("raw"), knownvalue.StringExact(fmt.Sprintf("path:src/payments/* #%[1]s\ntags.sku_class:enterprise #%[1]s", team))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("fallthrough"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("auto_assignment"), knownvalue.StringExact("Auto Assign to Suspect Commits")),
+				},
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_ownership" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	raw          = "module:payments #${sentry_team.test.slug}"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("raw"), knownvalue.StringExact(fmt.Sprintf("module:payments #%s", team))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules"), knownvalue.Null()),
+					// Settings that are no longer configured are left as is.
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("fallthrough"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_ownership" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	raw          = "module:payments #does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`Team #does-not-exist of rule`),
+			},
+		},
+	})
+}
@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectPerformanceIssueSettingsResource{}
+var _ resource.ResourceWithConfigure = &ProjectPerformanceIssueSettingsResource{}
+var _ resource.ResourceWithImportState = &ProjectPerformanceIssueSettingsResource{}
+
+// performanceIssueDetector describes the settings of a performance issue
+// detector: a flag that enables it and, for most detectors, a threshold.
+type performanceIssueDetector struct {
+	attribute   string
+	description string
+	enabledKey  string
+	threshold   *performanceIssueThreshold
+}
+
+type performanceIssueThreshold struct {
+	attribute   string
+	description string
+	key         string
+	// ratio thresholds are between 0 and 1, the others are whole numbers.
+	ratio bool
+}
+
+func (d performanceIssueDetector) attributeTypes() map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{
+		"enabled": types.BoolType,
+	}
+	if d.threshold != nil {
+		if d.threshold.ratio {
+			attributeTypes[d.threshold.attribute] = types.Float64Type
+		} else {
+			attributeTypes[d.threshold.attribute] = types.Int64Type
+		}
+	}
+	return attributeTypes
+}
+
+var performanceIssueDetectors = []performanceIssueDetector{
+	{
+		attribute:   "n_plus_one_db_queries",
+		description: "N+1 DB queries",
+		enabledKey:  "n_plus_one_db_queries_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "duration_threshold", description: "The minimum total duration of the repeated queries, in milliseconds.", key: "n_plus_one_db_duration_threshold"},
+	},
+	{
+		attribute:   "n_plus_one_api_calls",
+		description: "N+1 API calls",
+		enabledKey:  "n_plus_one_api_calls_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "duration_threshold", description: "The minimum total duration of the repeated calls, in milliseconds.", key: "n_plus_one_api_calls_total_duration_threshold"},
+	},
+	{
+		attribute:   "slow_db_queries",
+		description: "slow DB queries",
+		enabledKey:  "slow_db_queries_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "duration_threshold", description: "The minimum duration of a slow query, in milliseconds.", key: "slow_db_query_duration_threshold"},
+	},
+	{
+		attribute:   "consecutive_db_queries",
+		description: "consecutive DB queries",
+		enabledKey:  "consecutive_db_queries_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "min_time_saved_threshold", description: "The minimum time that running the queries in parallel would save, in milliseconds.", key: "consecutive_db_min_time_saved_threshold"},
+	},
+	{
+		attribute:   "consecutive_http_spans",
+		description: "consecutive HTTP",
+		enabledKey:  "consecutive_http_spans_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "min_time_saved_threshold", description: "The minimum time that running the requests in parallel would save, in milliseconds.", key: "consecutive_http_spans_min_time_saved_threshold"},
+	},
+	{
+		attribute:   "large_http_payload",
+		description: "large HTTP payload",
+		enabledKey:  "large_http_payload_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "size_threshold", description: "The minimum size of a payload, in bytes.", key: "large_http_payload_size_threshold"},
+	},
+	{
+		attribute:   "large_render_blocking_asset",
+		description: "large render-blocking asset",
+		enabledKey:  "large_render_blocking_asset_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "fcp_ratio_threshold", description: "The minimum fraction of the First Contentful Paint spent loading the asset, between `0` and `1`.", key: "render_blocking_fcp_ratio", ratio: true},
+	},
+	{
+		attribute:   "uncompressed_assets",
+		description: "uncompressed assets",
+		enabledKey:  "uncompressed_assets_detection_enabled",
+	},
+	{
+		attribute:   "file_io_on_main_thread",
+		description: "file I/O on main thread",
+		enabledKey:  "file_io_on_main_thread_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "duration_threshold", description: "The minimum duration of the file I/O, in milliseconds.", key: "file_io_on_main_thread_duration_threshold"},
+	},
+	{
+		attribute:   "db_on_main_thread",
+		description: "DB on main thread",
+		enabledKey:  "db_on_main_thread_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "duration_threshold", description: "The minimum duration of the query, in milliseconds.", key: "db_on_main_thread_duration_threshold"},
+	},
+	{
+		attribute:   "http_overhead",
+		description: "HTTP/1.1 overhead",
+		enabledKey:  "http_overhead_detection_enabled",
+		threshold:   &performanceIssueThreshold{attribute: "request_delay_threshold", description: "The minimum delay of the requests, in milliseconds.", key: "http_request_delay_threshold"},
+	},
+}
+
+func NewProjectPerformanceIssueSettingsResource() resource.Resource {
+	return &ProjectPerformanceIssueSettingsResource{}
+}
+
+type ProjectPerformanceIssueSettingsResource struct {
+	baseResource
+}
+
+type ProjectPerformanceIssueSettingsResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Organization             types.String `tfsdk:"organization"`
+	Project                  types.String `tfsdk:"project"`
+	NPlusOneDbQueries        types.Object `tfsdk:"n_plus_one_db_queries"`
+	NPlusOneApiCalls         types.Object `tfsdk:"n_plus_one_api_calls"`
+	SlowDbQueries            types.Object `tfsdk:"slow_db_queries"`
+	ConsecutiveDbQueries     types.Object `tfsdk:"consecutive_db_queries"`
+	ConsecutiveHttpSpans     types.Object `tfsdk:"consecutive_http_spans"`
+	LargeHttpPayload         types.Object `tfsdk:"large_http_payload"`
+	LargeRenderBlockingAsset types.Object `tfsdk:"large_render_blocking_asset"`
+	UncompressedAssets       types.Object `tfsdk:"uncompressed_assets"`
+	FileIoOnMainThread       types.Object `tfsdk:"file_io_on_main_thread"`
+	DbOnMainThread           types.Object `tfsdk:"db_on_main_thread"`
+	HttpOverhead             types.Object `tfsdk:"http_overhead"`
+}
+
+func (m *ProjectPerformanceIssueSettingsResourceModel) detectorObjects() map[string]*types.Object {
+	return map[string]*types.Object{
+		"n_plus_one_db_queries":       &m.NPlusOneDbQueries,
+		"n_plus_one_api_calls":        &m.NPlusOneApiCalls,
+		"slow_db_queries":             &m.SlowDbQueries,
+		"consecutive_db_queries":      &m.ConsecutiveDbQueries,
+		"consecutive_http_spans":      &m.ConsecutiveHttpSpans,
+		"large_http_payload":          &m.LargeHttpPayload,
+		"large_render_blocking_asset": &m.LargeRenderBlockingAsset,
+		"uncompressed_assets":         &m.UncompressedAssets,
+		"file_io_on_main_thread":      &m.FileIoOnMainThread,
+		"db_on_main_thread":           &m.DbOnMainThread,
+		"http_overhead":               &m.HttpOverhead,
+	}
+}
+
+// Fill reads the settings back into the detectors and attributes that are
+// already set in the model. The others are not managed and stay null.
+func (m *ProjectPerformanceIssueSettingsResourceModel) Fill(organization string, project string, settings sentryclient.ProjectPerformanceIssueSettings) error {
+	m.Id = types.StringValue(buildTwoPartID(organization, project))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+
+	objects := m.detectorObjects()
+	for _, detector := range performanceIssueDetectors {
+		object := objects[detector.attribute]
+		if object.IsNull() {
+			*object = types.ObjectNull(detector.attributeTypes())
+			continue
+		}
+
+		attributes := object.Attributes()
+		if !attributes["enabled"].IsNull() {
+			enabled, ok := settings[detector.enabledKey].(bool)
+			if !ok {
+				return fmt.Errorf("unexpected value %v for setting %s", settings[detector.enabledKey], detector.enabledKey)
+			}
+			attributes["enabled"] = types.BoolValue(enabled)
+		}
+		if detector.threshold != nil && !attributes[detector.threshold.attribute].IsNull() {
+			threshold, ok := settings[detector.threshold.key].(float64)
+			if !ok {
+				return fmt.Errorf("unexpected value %v for setting %s", settings[detector.threshold.key], detector.threshold.key)
+			}
+			if detector.threshold.ratio {
+				attributes[detector.threshold.attribute] = types.Float64Value(threshold)
+			} else {
+				attributes[detector.threshold.attribute] = types.Int64Value(int64(threshold))
+			}
+		}
+
+		value, diags := types.ObjectValue(detector.attributeTypes(), attributes)
+		if diags.HasError() {
+			return fmt.Errorf("unable to build %s: %v", detector.attribute, diags)
+		}
+		*object = value
+	}
+
+	return nil
+}
+
+// params returns the settings of the detectors and attributes that are set.
+func (m *ProjectPerformanceIssueSettingsResourceModel) params() sentryclient.ProjectPerformanceIssueSettings {
+	params := sentryclient.ProjectPerformanceIssueSettings{}
+
+	objects := m.detectorObjects()
+	for _, detector := range performanceIssueDetectors {
+		object := objects[detector.attribute]
+		if object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		attributes := object.Attributes()
+		if enabled, ok := attributes["enabled"].(types.Bool); ok && !enabled.IsNull() && !enabled.IsUnknown() {
+			params[detector.enabledKey] = enabled.ValueBool()
+		}
+		if detector.threshold == nil {
+			continue
+		}
+		switch threshold := attributes[detector.threshold.attribute].(type) {
+		case types.Int64:
+			if !threshold.IsNull() && !threshold.IsUnknown() {
+				params[detector.threshold.key] = threshold.ValueInt64()
+			}
+		case types.Float64:
+			if !threshold.IsNull() && !threshold.IsUnknown() {
+				params[detector.threshold.key] = threshold.ValueFloat64()
+			}
+		}
+	}
+
+	return params
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_performance_issue_settings"
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of this resource.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"organization": schema.StringAttribute{
+			MarkdownDescription: "The slug of the organization the project belongs to.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"project": schema.StringAttribute{
+			MarkdownDescription: "The slug of the project.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+
+	for _, detector := range performanceIssueDetectors {
+		detectorAttributes := map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the detector is enabled.",
+				Optional:            true,
+			},
+		}
+		if detector.threshold != nil {
+			if detector.threshold.ratio {
+				detectorAttributes[detector.threshold.attribute] = schema.Float64Attribute{
+					MarkdownDescription: detector.threshold.description,
+					Optional:            true,
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				}
+			} else {
+				detectorAttributes[detector.threshold.attribute] = schema.Int64Attribute{
+					MarkdownDescription: detector.threshold.description,
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				}
+			}
+		}
+
+		attributes[detector.attribute] = schema.SingleNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("The settings of the %s detector.", detector.description),
+			Optional:            true,
+			Attributes:          detectorAttributes,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Performance Issue Settings resource. Tunes the performance issue detectors of a project.\n\n" +
+			"Only the detectors and attributes that are set are managed, the others keep the values set in the Sentry UI. Destroying the resource leaves the settings as they are.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) update(ctx context.Context, data *ProjectPerformanceIssueSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := data.params()
+	tflog.Debug(ctx, "Updating project performance issue settings", map[string]interface{}{
+		"org":      data.Organization.ValueString(),
+		"project":  data.Project.ValueString(),
+		"settings": len(params),
+	})
+
+	var settings sentryclient.ProjectPerformanceIssueSettings
+	var err error
+	if len(params) > 0 {
+		settings, _, err = sentryclient.UpdateProjectPerformanceIssueSettings(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), params)
+	} else {
+		settings, _, err = sentryclient.GetProjectPerformanceIssueSettings(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error updating project performance issue settings: %s", err.Error()))
+		return diags
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), settings); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+	}
+	return diags
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, apiResp, err := sentryclient.GetProjectPerformanceIssueSettings(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Project.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project performance issue settings: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), settings); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings are left as they are.
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, err := splitTwoPartID(req.ID, "organization", "project-slug")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestProjectPerformanceIssueSettingsResourceModel(t *testing.T) {
+	t.Parallel()
+
+	data := ProjectPerformanceIssueSettingsResourceModel{
+		NPlusOneDbQueries: types.ObjectValueMust(
+			map[string]attr.Type{"enabled": types.BoolType, "duration_threshold": types.Int64Type},
+			map[string]attr.Value{"enabled": types.BoolValue(false), "duration_threshold": types.Int64Null()},
+		),
+		LargeRenderBlockingAsset: types.ObjectValueMust(
+			map[string]attr.Type{"enabled": types.BoolType, "fcp_ratio_threshold": types.Float64Type},
+			map[string]attr.Value{"enabled": types.BoolNull(), "fcp_ratio_threshold": types.Float64Value(0.5)},
+		),
+	}
+	for _, detector := range performanceIssueDetectors {
+		if object := data.detectorObjects()[detector.attribute]; object.Attributes() == nil {
+			*object = types.ObjectNull(detector.attributeTypes())
+		}
+	}
+
+	expectedParams := sentryclient.ProjectPerformanceIssueSettings{
+		"n_plus_one_db_queries_detection_enabled": false,
+		"render_blocking_fcp_ratio":               0.5,
+	}
+	if diff := cmp.Diff(expectedParams, data.params()); diff != "" {
+		t.Errorf("unexpected params (-want +got):\n%s", diff)
+	}
+
+	err := data.Fill("my-org", "my-project", sentryclient.ProjectPerformanceIssueSettings{
+		"n_plus_one_db_queries_detection_enabled":       true,
+		"n_plus_one_db_duration_threshold":              float64(100),
+		"large_render_blocking_asset_detection_enabled": true,
+		"render_blocking_fcp_ratio":                     0.33,
+		"slow_db_queries_detection_enabled":             true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only the attributes that were set are read back.
+	expectedNPlusOne := types.ObjectValueMust(
+		map[string]attr.Type{"enabled": types.BoolType, "duration_threshold": types.Int64Type},
+		map[string]attr.Value{"enabled": types.BoolValue(true), "duration_threshold": types.Int64Null()},
+	)
+	if !data.NPlusOneDbQueries.Equal(expectedNPlusOne) {
+		t.Errorf("unexpected n_plus_one_db_queries: %s", data.NPlusOneDbQueries)
+	}
+	expectedRenderBlocking := types.ObjectValueMust(
+		map[string]attr.Type{"enabled": types.BoolType, "fcp_ratio_threshold": types.Float64Type},
+		map[string]attr.Value{"enabled": types.BoolNull(), "fcp_ratio_threshold": types.Float64Value(0.33)},
+	)
+	if !data.LargeRenderBlockingAsset.Equal(expectedRenderBlocking) {
+		t.Errorf("unexpected large_render_blocking_asset: %s", data.LargeRenderBlockingAsset)
+	}
+	if !data.SlowDbQueries.IsNull() {
+		t.Errorf("expected slow_db_queries to stay null, got %s", data.SlowDbQueries)
+	}
+}
+
+func TestAccProjectPerformanceIssueSettingsResource(t *testing.T) {
+	rn := "sentry_project_performance_issue_settings.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_performance_issue_settings" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	n_plus_one_db_queries = {
+		enabled = false
+	}
+	slow_db_queries = {
+		duration_threshold = 2000
+	}
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(fmt.Sprintf("%s/%s", acctest.TestOrganization, project))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("n_plus_one_db_queries"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"enabled":            knownvalue.Bool(false),
+						"duration_threshold": knownvalue.Null(),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("slow_db_queries"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"enabled":            knownvalue.Null(),
+						"duration_threshold": knownvalue.Int64Exact(2000),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("http_overhead"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_performance_issue_settings" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	large_render_blocking_asset = {
+		enabled             = true
+		fcp_ratio_threshold = 0.5
+	}
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("n_plus_one_db_queries"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("large_render_blocking_asset"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"enabled":             knownvalue.Bool(true),
+						"fcp_ratio_threshold": knownvalue.Float64Exact(0.5),
+					})),
+				},
+			},
+		},
+	})
+}
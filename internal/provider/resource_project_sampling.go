@@ -0,0 +1,473 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectSamplingResource{}
+var _ resource.ResourceWithConfigure = &ProjectSamplingResource{}
+var _ resource.ResourceWithImportState = &ProjectSamplingResource{}
+
+// samplingConditionNames maps the condition attributes of a sampling rule to
+// the fields of the trace they match.
+var samplingConditionNames = map[string]string{
+	"transactions": "trace.transaction",
+	"environments": "trace.environment",
+	"releases":     "trace.release",
+}
+
+// samplingConditionAliases maps the event fields that rules created in the
+// Sentry UI may use to the equivalent condition attribute.
+var samplingConditionAliases = map[string]string{
+	"event.transaction": "transactions",
+	"event.environment": "environments",
+	"event.release":     "releases",
+}
+
+func NewProjectSamplingResource() resource.Resource {
+	return &ProjectSamplingResource{}
+}
+
+type ProjectSamplingResource struct {
+	baseResource
+}
+
+type ProjectSamplingRuleModel struct {
+	SampleRate   types.Float64 `tfsdk:"sample_rate"`
+	Transactions types.Set     `tfsdk:"transactions"`
+	Environments types.Set     `tfsdk:"environments"`
+	Releases     types.Set     `tfsdk:"releases"`
+	Start        types.String  `tfsdk:"start"`
+	End          types.String  `tfsdk:"end"`
+}
+
+// samplingConditionAttribute returns the condition attribute of a trace or
+// event field, or false if the field cannot be represented by
+// ProjectSamplingRuleModel.
+func samplingConditionAttribute(name string) (string, bool) {
+	if attribute, ok := samplingConditionAliases[name]; ok {
+		return attribute, true
+	}
+	for attribute, n := range samplingConditionNames {
+		if n == name {
+			return attribute, true
+		}
+	}
+	return "", false
+}
+
+// samplingRuleSupported reports whether all the conditions of a rule can be
+// represented by ProjectSamplingRuleModel. Rules added in the Sentry UI may
+// match other fields, e.g. `trace.user.segment`.
+func samplingRuleSupported(rule sentryclient.DynamicSamplingRule) bool {
+	for _, inner := range rule.Condition.Inner {
+		if _, ok := samplingConditionAttribute(inner.Name); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Fill sets the rule from a remote rule. An unsupported rule is filled with its
+// sample rate only, so that it shows up as drift and the next apply replaces
+// it, see unsupportedSamplingRuleWarnings.
+func (m *ProjectSamplingRuleModel) Fill(rule sentryclient.DynamicSamplingRule) error {
+	m.SampleRate = types.Float64Value(rule.SampleRate)
+
+	conditions := map[string][]string{}
+	if samplingRuleSupported(rule) {
+		for _, inner := range rule.Condition.Inner {
+			attribute, _ := samplingConditionAttribute(inner.Name)
+			conditions[attribute] = append(conditions[attribute], inner.Value...)
+		}
+	}
+
+	m.Transactions = samplingConditionSet(conditions["transactions"])
+	m.Environments = samplingConditionSet(conditions["environments"])
+	m.Releases = samplingConditionSet(conditions["releases"])
+
+	if rule.TimeRange != nil {
+		m.Start = sameInstant(m.Start, rule.TimeRange.Start)
+		m.End = sameInstant(m.End, rule.TimeRange.End)
+	} else {
+		m.Start = types.StringNull()
+		m.End = types.StringNull()
+	}
+
+	return nil
+}
+
+// unsupportedSamplingRuleWarnings warns about each rule that
+// ProjectSamplingRuleModel cannot represent.
+func unsupportedSamplingRuleWarnings(sampling sentryclient.ProjectSampling) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if sampling.DynamicSampling == nil {
+		return diags
+	}
+
+	for i, rule := range sampling.DynamicSampling.Rules {
+		if samplingRuleSupported(rule) {
+			continue
+		}
+		var names []string
+		for _, inner := range rule.Condition.Inner {
+			if _, ok := samplingConditionAttribute(inner.Name); !ok {
+				names = append(names, inner.Name)
+			}
+		}
+		diags.AddAttributeWarning(
+			path.Root("rules").AtListIndex(i),
+			"Unsupported Sampling Rule",
+			fmt.Sprintf("Rule %d matches on %q, which this resource cannot manage. It is shown without conditions and is replaced by the configured rules on the next apply.", rule.ID, names),
+		)
+	}
+	return diags
+}
+
+func samplingConditionSet(values []string) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return types.SetValueMust(types.StringType, stringsToValues(values))
+}
+
+// sameInstant returns the prior timestamp if it is the same instant as the
+// remote one, so that Sentry reformatting a timestamp does not cause drift.
+func sameInstant(prior types.String, remote string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorTime, priorErr := time.Parse(time.RFC3339, prior.ValueString())
+		remoteTime, remoteErr := time.Parse(time.RFC3339, remote)
+		if priorErr == nil && remoteErr == nil && priorTime.Equal(remoteTime) {
+			return prior
+		}
+	}
+	return types.StringValue(remote)
+}
+
+type ProjectSamplingResourceModel struct {
+	Id               types.String               `tfsdk:"id"`
+	Organization     types.String               `tfsdk:"organization"`
+	Project          types.String               `tfsdk:"project"`
+	TargetSampleRate types.Float64              `tfsdk:"target_sample_rate"`
+	Rules            []ProjectSamplingRuleModel `tfsdk:"rules"`
+}
+
+func (m *ProjectSamplingResourceModel) Fill(organization string, project string, sampling sentryclient.ProjectSampling) error {
+	m.Id = types.StringValue(buildTwoPartID(organization, project))
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.TargetSampleRate = types.Float64PointerValue(sampling.TargetSampleRate)
+
+	var remoteRules []sentryclient.DynamicSamplingRule
+	if sampling.DynamicSampling != nil {
+		remoteRules = sampling.DynamicSampling.Rules
+	}
+
+	var rules []ProjectSamplingRuleModel
+	for i, remoteRule := range remoteRules {
+		var rule ProjectSamplingRuleModel
+		if i < len(m.Rules) {
+			rule = m.Rules[i]
+		}
+		if err := rule.Fill(remoteRule); err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+	m.Rules = rules
+
+	return nil
+}
+
+func (r *ProjectSamplingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_sampling"
+}
+
+func (r *ProjectSamplingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	conditionAttribute := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		}
+	}
+	timeAttribute := func(description string, other string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Validators: []validator.String{
+				rfc3339Validator{},
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(other)),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Sampling resource. Manages the dynamic sampling of a project: its target sample rate and the custom rules that sample matching traces at a fixed rate, e.g. to drop most health check transactions.\n\n" +
+			"The rules are authoritative: rules added in the Sentry UI are removed, and destroying the resource removes all the rules. The target sample rate is left as is on destroy.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_sample_rate": schema.Float64Attribute{
+				MarkdownDescription: "The target sample rate of the project, between `0` and `1`. Only used when the sampling mode of the organization is `project`. Left as is if not set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The custom sampling rules, in order of priority. A trace matches a rule when it matches all of its conditions.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sample_rate": schema.Float64Attribute{
+							MarkdownDescription: "The rate at which matching traces are sampled, between `0` and `1`.",
+							Required:            true,
+							Validators: []validator.Float64{
+								float64validator.Between(0, 1),
+							},
+						},
+						"transactions": conditionAttribute("Match traces whose root transaction matches one of these glob patterns, e.g. `/health*`."),
+						"environments": conditionAttribute("Match traces from one of these environments. Glob patterns are supported."),
+						"releases":     conditionAttribute("Match traces from one of these releases. Glob patterns are supported."),
+						"start":        timeAttribute("The RFC 3339 timestamp from which the rule applies. Requires `end`.", "end"),
+						"end":          timeAttribute("The RFC 3339 timestamp until which the rule applies. Requires `start`.", "start"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectSamplingResource) params(ctx context.Context, data ProjectSamplingResourceModel) (*sentryclient.ProjectSamplingParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := &sentryclient.ProjectSamplingParams{
+		DynamicSampling: &sentryclient.ProjectDynamicSampling{
+			Rules: []sentryclient.DynamicSamplingRule{},
+		},
+	}
+	if !data.TargetSampleRate.IsUnknown() {
+		params.TargetSampleRate = data.TargetSampleRate.ValueFloat64Pointer()
+	}
+
+	for i, rule := range data.Rules {
+		remoteRule := sentryclient.DynamicSamplingRule{
+			ID:         i + 1,
+			Type:       "trace",
+			SampleRate: rule.SampleRate.ValueFloat64(),
+			Condition: sentryclient.DynamicSamplingCondition{
+				Op:    "and",
+				Inner: []sentryclient.DynamicSamplingInnerCondition{},
+			},
+		}
+
+		conditions := map[string]types.Set{
+			"transactions": rule.Transactions,
+			"environments": rule.Environments,
+			"releases":     rule.Releases,
+		}
+		for _, attribute := range []string{"transactions", "environments", "releases"} {
+			set := conditions[attribute]
+			if set.IsNull() {
+				continue
+			}
+			var values []string
+			diags.Append(set.ElementsAs(ctx, &values, false)...)
+			remoteRule.Condition.Inner = append(remoteRule.Condition.Inner, sentryclient.DynamicSamplingInnerCondition{
+				Op:    "glob",
+				Name:  samplingConditionNames[attribute],
+				Value: values,
+			})
+		}
+
+		if !rule.Start.IsNull() {
+			remoteRule.TimeRange = &sentryclient.DynamicSamplingTimeRange{
+				Start: rule.Start.ValueString(),
+				End:   rule.End.ValueString(),
+			}
+		}
+
+		params.DynamicSampling.Rules = append(params.DynamicSampling.Rules, remoteRule)
+	}
+
+	return params, diags
+}
+
+func (r *ProjectSamplingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectSamplingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := r.params(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating project sampling", map[string]interface{}{
+		"org":     data.Organization.ValueString(),
+		"project": data.Project.ValueString(),
+		"rules":   len(params.DynamicSampling.Rules),
+	})
+	sampling, _, err := sentryclient.UpdateProjectSampling(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating project sampling: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *sampling); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSamplingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectSamplingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sampling, apiResp, err := sentryclient.GetProjectSampling(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Project not found: %s", data.Project.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading project sampling: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(unsupportedSamplingRuleWarnings(*sampling)...)
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *sampling); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSamplingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectSamplingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := r.params(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sampling, _, err := sentryclient.UpdateProjectSampling(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating project sampling: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *sampling); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSamplingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectSamplingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, apiResp, err := sentryclient.UpdateProjectSampling(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), &sentryclient.ProjectSamplingParams{
+		DynamicSampling: &sentryclient.ProjectDynamicSampling{
+			Rules: []sentryclient.DynamicSamplingRule{},
+		},
+	})
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing project sampling rules: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectSamplingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, err := splitTwoPartID(req.ID, "organization", "project-slug")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
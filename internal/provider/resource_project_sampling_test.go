@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+func TestProjectSamplingResourceModelFill(t *testing.T) {
+	t.Parallel()
+
+	data := ProjectSamplingResourceModel{
+		Rules: []ProjectSamplingRuleModel{
+			{Start: types.StringValue("2024-01-01T10:00:00+10:00"), End: types.StringValue("2024-01-02T00:00:00Z")},
+		},
+	}
+	err := data.Fill("my-org", "my-project", sentryclient.ProjectSampling{
+		TargetSampleRate: sentry.Float64(0.5),
+		DynamicSampling: &sentryclient.ProjectDynamicSampling{
+			Rules: []sentryclient.DynamicSamplingRule{
+				{
+					ID:         1,
+					Type:       "trace",
+					SampleRate: 0.01,
+					Condition: sentryclient.DynamicSamplingCondition{
+						Op: "and",
+						Inner: []sentryclient.DynamicSamplingInnerCondition{
+							{Op: "glob", Name: "trace.transaction", Value: []string{"/health*"}},
+							{Op: "glob", Name: "event.environment", Value: []string{"production"}},
+						},
+					},
+					TimeRange: &sentryclient.DynamicSamplingTimeRange{
+						Start: "2024-01-01T00:00:00.000000Z",
+						End:   "2024-01-03T00:00:00Z",
+					},
+				},
+				{
+					ID:         2,
+					Type:       "trace",
+					SampleRate: 1,
+					Condition: sentryclient.DynamicSamplingCondition{
+						Op:    "and",
+						Inner: []sentryclient.DynamicSamplingInnerCondition{},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []ProjectSamplingRuleModel{
+		{
+			SampleRate:   types.Float64Value(0.01),
+			Transactions: types.SetValueMust(types.StringType, stringsToValues([]string{"/health*"})),
+			Environments: types.SetValueMust(types.StringType, stringsToValues([]string{"production"})),
+			Releases:     types.SetNull(types.StringType),
+			// The same instant as the prior value is kept.
+			Start: types.StringValue("2024-01-01T10:00:00+10:00"),
+			End:   types.StringValue("2024-01-03T00:00:00Z"),
+		},
+		{
+			SampleRate:   types.Float64Value(1),
+			Transactions: types.SetNull(types.StringType),
+			Environments: types.SetNull(types.StringType),
+			Releases:     types.SetNull(types.StringType),
+			Start:        types.StringNull(),
+			End:          types.StringNull(),
+		},
+	}
+	if diff := cmp.Diff(expected, data.Rules); diff != "" {
+		t.Errorf("unexpected rules (-want +got):\n%s", diff)
+	}
+	if !data.TargetSampleRate.Equal(types.Float64Value(0.5)) {
+		t.Errorf("unexpected target sample rate: %s", data.TargetSampleRate)
+	}
+
+	// A rule with an unsupported condition is kept without its conditions, with
+	// a warning, so that it shows up as drift.
+	unsupported := sentryclient.ProjectSampling{
+		DynamicSampling: &sentryclient.ProjectDynamicSampling{
+			Rules: []sentryclient.DynamicSamplingRule{
+				{ID: 7, SampleRate: 0.2, Condition: sentryclient.DynamicSamplingCondition{Inner: []sentryclient.DynamicSamplingInnerCondition{
+					{Op: "glob", Name: "trace.environment", Value: []string{"production"}},
+					{Op: "eq", Name: "trace.user.segment", Value: []string{"vip"}},
+				}}},
+			},
+		},
+	}
+	if err := data.Fill("my-org", "my-project", unsupported); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []ProjectSamplingRuleModel{
+		{
+			SampleRate:   types.Float64Value(0.2),
+			Transactions: types.SetNull(types.StringType),
+			Environments: types.SetNull(types.StringType),
+			Releases:     types.SetNull(types.StringType),
+			Start:        types.StringNull(),
+			End:          types.StringNull(),
+		},
+	}
+	if diff := cmp.Diff(expected, data.Rules); diff != "" {
+		t.Errorf("unexpected rules (-want +got):\n%s", diff)
+	}
+
+	diags := unsupportedSamplingRuleWarnings(unsupported)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %v", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "trace.user.segment") || strings.Contains(detail, "trace.environment") {
+		t.Errorf("unexpected warning: %q", detail)
+	}
+}
+
+func TestAccProjectSamplingResource(t *testing.T) {
+	rn := "sentry_project_sampling.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(team, project) + `
+resource "sentry_project_sampling" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+
+	rules = [
+		{
+			sample_rate  = 0.01
+			transactions = ["/health*", "/ready"]
+			environments = ["production"]
+		},
+		{
+			sample_rate = 1
+			releases    = ["2.0.0-rc*"]
+			start       = "2030-01-01T00:00:00Z"
+			end         = "2030-01-08T00:00:00Z"
+		},
+	]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(fmt.Sprintf("%s/%s", acctest.TestOrganization, project))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtSliceIndex(0).AtMapKey("transactions"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("/health*"),
+						knownvalue.StringExact("/ready"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("rules").AtSliceIndex(1).AtMapKey("start"), knownvalue.StringExact("2030-01-01T00:00:00Z")),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
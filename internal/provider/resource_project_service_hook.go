@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ProjectServiceHookResource{}
+var _ resource.ResourceWithConfigure = &ProjectServiceHookResource{}
+var _ resource.ResourceWithImportState = &ProjectServiceHookResource{}
+
+var serviceHookEvents = []string{"event.alert", "event.created"}
+
+func NewProjectServiceHookResource() resource.Resource {
+	return &ProjectServiceHookResource{}
+}
+
+type ProjectServiceHookResource struct {
+	baseResource
+}
+
+type ProjectServiceHookResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Url          types.String `tfsdk:"url"`
+	Events       types.Set    `tfsdk:"events"`
+	Status       types.String `tfsdk:"status"`
+	Secret       types.String `tfsdk:"secret"`
+}
+
+func (m *ProjectServiceHookResourceModel) Fill(organization string, project string, hook sentryclient.ServiceHook) error {
+	m.Id = types.StringValue(hook.ID)
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Url = types.StringValue(hook.URL)
+	m.Events = types.SetValueMust(types.StringType, stringsToValues(hook.Events))
+	m.Status = types.StringValue(hook.Status)
+	m.Secret = types.StringValue(hook.Secret)
+
+	return nil
+}
+
+func (m ProjectServiceHookResourceModel) params(ctx context.Context) (*sentryclient.ServiceHookParams, diag.Diagnostics) {
+	params := &sentryclient.ServiceHookParams{
+		URL: m.Url.ValueString(),
+	}
+	diags := m.Events.ElementsAs(ctx, &params.Events, false)
+	return params, diags
+}
+
+func (r *ProjectServiceHookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_service_hook"
+}
+
+func (r *ProjectServiceHookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Service Hook resource. Service hooks are a legacy integration that posts the events of a project to a URL, signed with a secret generated by Sentry.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL the events are posted to.",
+				Required:            true,
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "The events that trigger the hook. Valid values are `event.alert` and `event.created`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(serviceHookEvents...)),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the hook. One of `active` or `disabled`. Defaults to `active`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "disabled"),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret used to sign the payloads sent to the URL.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectServiceHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectServiceHookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.params(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, _, err := sentryclient.CreateServiceHook(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating service hook: %s", err.Error()))
+		return
+	}
+
+	// New hooks are always active. Save the hook before changing its status,
+	// so that it is not orphaned if the update fails.
+	status := data.Status
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *hook); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() || status.ValueString() == hook.Status {
+		return
+	}
+
+	params.IsActive = sentry.Bool(status.ValueString() == "active")
+	hook, _, err = sentryclient.UpdateServiceHook(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), hook.ID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating service hook: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *hook); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectServiceHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectServiceHookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, apiResp, err := sentryclient.GetServiceHook(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Service hook not found: %s", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading service hook: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *hook); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectServiceHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectServiceHookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.params(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.IsActive = sentry.Bool(data.Status.ValueString() == "active")
+
+	hook, apiResp, err := sentryclient.UpdateServiceHook(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString(), params)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Service hook not found: %s", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating service hook: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *hook); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectServiceHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectServiceHookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := sentryclient.DeleteServiceHook(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting service hook: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ProjectServiceHookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, id, err := splitThreePartID(req.ID, "organization", "project-slug", "id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), id,
+	)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccProjectServiceHookResource(t *testing.T) {
+	rn := "sentry_project_service_hook.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServiceHookResourceConfig(team, project, `events = ["event.alert"]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("url"), knownvalue.StringExact("https://example.com/sentry-hook")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("events"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("event.alert"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("status"), knownvalue.StringExact("active")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("secret"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccProjectServiceHookResourceConfig(team, project, `
+	events = ["event.alert", "event.created"]
+	status = "disabled"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("events"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("event.alert"),
+						knownvalue.StringExact("event.created"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("status"), knownvalue.StringExact("disabled")),
+				},
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", rn)
+					}
+					organization := rs.Primary.Attributes["organization"]
+					project := rs.Primary.Attributes["project"]
+					return buildThreePartID(organization, project, rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectServiceHookResourceConfig(team, project, extras string) string {
+	return testAccProjectResourceConfig(team, project) + fmt.Sprintf(`
+resource "sentry_project_service_hook" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	url          = "https://example.com/sentry-hook"
+	%[1]s
+}
+`, extras)
+}
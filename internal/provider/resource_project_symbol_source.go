@@ -2,11 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,12 +18,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
 	"github.com/jianyuan/go-sentry/v2/sentry"
 )
 
 var _ resource.Resource = &ProjectSymbolSourcesResource{}
 var _ resource.ResourceWithConfigure = &ProjectSymbolSourcesResource{}
 var _ resource.ResourceWithImportState = &ProjectSymbolSourcesResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectSymbolSourcesResource{}
+var _ resource.ResourceWithUpgradeState = &ProjectSymbolSourcesResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectSymbolSourcesResource{}
+
+// symbolSourceTypeAttributes maps the types of symbol sources to the attribute
+// holding their settings.
+var symbolSourceTypeAttributes = map[string]string{
+	"appStoreConnect": "app_store_connect",
+	"http":            "http",
+	"gcs":             "gcs",
+	"s3":              "s3",
+}
 
 func NewProjectSymbolSourcesResource() resource.Resource {
 	return &ProjectSymbolSourcesResource{}
@@ -31,50 +47,224 @@ type ProjectSymbolSourcesResource struct {
 }
 
 type ProjectSymbolSourcesResourceModel struct {
-	Id                   types.String                             `tfsdk:"id"`
-	Organization         types.String                             `tfsdk:"organization"`
-	Project              types.String                             `tfsdk:"project"`
-	Type                 types.String                             `tfsdk:"type"`
-	Name                 types.String                             `tfsdk:"name"`
-	Layout               *ProjectSymbolSourcesResourceLayoutModel `tfsdk:"layout"`
-	AppConnectIssuer     types.String                             `tfsdk:"app_connect_issuer"`
-	AppConnectPrivateKey types.String                             `tfsdk:"app_connect_private_key"`
-	AppId                types.String                             `tfsdk:"app_id"`
-	Url                  types.String                             `tfsdk:"url"`
-	Username             types.String                             `tfsdk:"username"`
-	Password             types.String                             `tfsdk:"password"`
-	Bucket               types.String                             `tfsdk:"bucket"`
-	Region               types.String                             `tfsdk:"region"`
-	AccessKey            types.String                             `tfsdk:"access_key"`
-	SecretKey            types.String                             `tfsdk:"secret_key"`
-	Prefix               types.String                             `tfsdk:"prefix"`
-	ClientEmail          types.String                             `tfsdk:"client_email"`
-	PrivateKey           types.String                             `tfsdk:"private_key"`
+	Id              types.String                                      `tfsdk:"id"`
+	Organization    types.String                                      `tfsdk:"organization"`
+	Project         types.String                                      `tfsdk:"project"`
+	Type            types.String                                      `tfsdk:"type"`
+	Name            types.String                                      `tfsdk:"name"`
+	Layout          *ProjectSymbolSourcesResourceLayoutModel          `tfsdk:"layout"`
+	AppStoreConnect *ProjectSymbolSourcesResourceAppStoreConnectModel `tfsdk:"app_store_connect"`
+	Http            *ProjectSymbolSourcesResourceHttpModel            `tfsdk:"http"`
+	Gcs             *ProjectSymbolSourcesResourceGcsModel             `tfsdk:"gcs"`
+	S3              *ProjectSymbolSourcesResourceS3Model              `tfsdk:"s3"`
+}
+
+type ProjectSymbolSourcesResourceAppStoreConnectModel struct {
+	Issuer     types.String `tfsdk:"issuer"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	AppId      types.String `tfsdk:"app_id"`
+}
+
+type ProjectSymbolSourcesResourceHttpModel struct {
+	Url      types.String `tfsdk:"url"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type ProjectSymbolSourcesResourceGcsModel struct {
+	Bucket      types.String `tfsdk:"bucket"`
+	Prefix      types.String `tfsdk:"prefix"`
+	ClientEmail types.String `tfsdk:"client_email"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+}
+
+type ProjectSymbolSourcesResourceS3Model struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Region    types.String `tfsdk:"region"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
 }
 
 func (data *ProjectSymbolSourcesResourceModel) Fill(source sentry.ProjectSymbolSource) error {
 	data.Id = types.StringPointerValue(source.ID)
 	data.Type = types.StringPointerValue(source.Type)
 	data.Name = types.StringPointerValue(source.Name)
+	data.Layout = nil
 	if source.Layout != nil {
 		data.Layout = &ProjectSymbolSourcesResourceLayoutModel{}
 		if err := data.Layout.Fill(*source.Layout); err != nil {
 			return err
 		}
 	}
-	data.AppConnectIssuer = types.StringPointerValue(source.AppConnectIssuer)
-	data.AppId = types.StringPointerValue(source.AppId)
-	data.Url = types.StringPointerValue(source.Url)
-	data.Username = types.StringPointerValue(source.Username)
-	data.Bucket = types.StringPointerValue(source.Bucket)
-	data.Region = types.StringPointerValue(source.Region)
-	data.AccessKey = types.StringPointerValue(source.AccessKey)
-	data.Prefix = types.StringPointerValue(source.Prefix)
-	data.ClientEmail = types.StringPointerValue(source.ClientEmail)
+
+	// The secrets are never returned, so they keep their prior values.
+	appStoreConnect, http, gcs, s3 := data.AppStoreConnect, data.Http, data.Gcs, data.S3
+	data.AppStoreConnect, data.Http, data.Gcs, data.S3 = nil, nil, nil, nil
+
+	switch data.Type.ValueString() {
+	case "appStoreConnect":
+		if appStoreConnect == nil {
+			appStoreConnect = &ProjectSymbolSourcesResourceAppStoreConnectModel{PrivateKey: types.StringNull()}
+		}
+		appStoreConnect.Issuer = types.StringPointerValue(source.AppConnectIssuer)
+		appStoreConnect.AppId = types.StringPointerValue(source.AppId)
+		data.AppStoreConnect = appStoreConnect
+	case "http":
+		if http == nil {
+			http = &ProjectSymbolSourcesResourceHttpModel{Password: types.StringNull()}
+		}
+		http.Url = types.StringPointerValue(source.Url)
+		http.Username = types.StringPointerValue(source.Username)
+		data.Http = http
+	case "gcs":
+		if gcs == nil {
+			gcs = &ProjectSymbolSourcesResourceGcsModel{PrivateKey: types.StringNull()}
+		}
+		gcs.Bucket = types.StringPointerValue(source.Bucket)
+		gcs.Prefix = types.StringPointerValue(source.Prefix)
+		gcs.ClientEmail = types.StringPointerValue(source.ClientEmail)
+		data.Gcs = gcs
+	case "s3":
+		if s3 == nil {
+			s3 = &ProjectSymbolSourcesResourceS3Model{SecretKey: types.StringNull()}
+		}
+		s3.Bucket = types.StringPointerValue(source.Bucket)
+		s3.Prefix = types.StringPointerValue(source.Prefix)
+		s3.Region = types.StringPointerValue(source.Region)
+		s3.AccessKey = types.StringPointerValue(source.AccessKey)
+		data.S3 = s3
+	default:
+		return fmt.Errorf("unsupported symbol source type: %s", data.Type.ValueString())
+	}
 
 	return nil
 }
 
+// symbolSourceFields holds the settings that are sent to Sentry for each type
+// of source.
+type symbolSourceFields struct {
+	AppConnectIssuer     *string
+	AppConnectPrivateKey *string
+	AppId                *string
+	Url                  *string
+	Username             *string
+	Password             *string
+	Bucket               *string
+	Region               *string
+	AccessKey            *string
+	SecretKey            *string
+	Prefix               *string
+	ClientEmail          *string
+	PrivateKey           *string
+}
+
+func (data ProjectSymbolSourcesResourceModel) fields() symbolSourceFields {
+	var fields symbolSourceFields
+	switch {
+	case data.AppStoreConnect != nil:
+		fields.AppConnectIssuer = data.AppStoreConnect.Issuer.ValueStringPointer()
+		fields.AppConnectPrivateKey = data.AppStoreConnect.PrivateKey.ValueStringPointer()
+		fields.AppId = data.AppStoreConnect.AppId.ValueStringPointer()
+	case data.Http != nil:
+		fields.Url = data.Http.Url.ValueStringPointer()
+		fields.Username = data.Http.Username.ValueStringPointer()
+		fields.Password = data.Http.Password.ValueStringPointer()
+	case data.Gcs != nil:
+		fields.Bucket = data.Gcs.Bucket.ValueStringPointer()
+		fields.Prefix = data.Gcs.Prefix.ValueStringPointer()
+		fields.ClientEmail = data.Gcs.ClientEmail.ValueStringPointer()
+		fields.PrivateKey = data.Gcs.PrivateKey.ValueStringPointer()
+	case data.S3 != nil:
+		fields.Bucket = data.S3.Bucket.ValueStringPointer()
+		fields.Prefix = data.S3.Prefix.ValueStringPointer()
+		fields.Region = data.S3.Region.ValueStringPointer()
+		fields.AccessKey = data.S3.AccessKey.ValueStringPointer()
+		fields.SecretKey = data.S3.SecretKey.ValueStringPointer()
+	}
+	return fields
+}
+
+func (data ProjectSymbolSourcesResourceModel) layout() *sentry.ProjectSymbolSourceLayout {
+	if data.Layout == nil {
+		return nil
+	}
+	return &sentry.ProjectSymbolSourceLayout{
+		Type:   data.Layout.Type.ValueStringPointer(),
+		Casing: data.Layout.Casing.ValueStringPointer(),
+	}
+}
+
+// secrets returns the write-only secrets of the source, which are never read
+// back from Sentry.
+func (data ProjectSymbolSourcesResourceModel) secrets() map[string]types.String {
+	secrets := map[string]types.String{}
+	if data.AppStoreConnect != nil {
+		secrets["app_store_connect.private_key"] = data.AppStoreConnect.PrivateKey
+	}
+	if data.Http != nil {
+		secrets["http.password"] = data.Http.Password
+	}
+	if data.Gcs != nil {
+		secrets["gcs.private_key"] = data.Gcs.PrivateKey
+	}
+	if data.S3 != nil {
+		secrets["s3.secret_key"] = data.S3.SecretKey
+	}
+	return secrets
+}
+
+func (data *ProjectSymbolSourcesResourceModel) clearSecret(name string) {
+	switch {
+	case name == "app_store_connect.private_key" && data.AppStoreConnect != nil:
+		data.AppStoreConnect.PrivateKey = types.StringNull()
+	case name == "http.password" && data.Http != nil:
+		data.Http.Password = types.StringNull()
+	case name == "gcs.private_key" && data.Gcs != nil:
+		data.Gcs.PrivateKey = types.StringNull()
+	case name == "s3.secret_key" && data.S3 != nil:
+		data.S3.SecretKey = types.StringNull()
+	}
+}
+
+// withoutSecrets returns a copy of the model without its secrets, to compare
+// the fields that are read back from Sentry.
+func (data ProjectSymbolSourcesResourceModel) withoutSecrets() ProjectSymbolSourcesResourceModel {
+	if data.AppStoreConnect != nil {
+		appStoreConnect := *data.AppStoreConnect
+		data.AppStoreConnect = &appStoreConnect
+	}
+	if data.Http != nil {
+		http := *data.Http
+		data.Http = &http
+	}
+	if data.Gcs != nil {
+		gcs := *data.Gcs
+		data.Gcs = &gcs
+	}
+	if data.S3 != nil {
+		s3 := *data.S3
+		data.S3 = &s3
+	}
+	for name := range data.secrets() {
+		data.clearSecret(name)
+	}
+	return data
+}
+
+// remoteSecrets returns the names of the secrets that Sentry reports as set.
+func remoteSecrets(source sentry.ProjectSymbolSource) map[string]bool {
+	isSet := func(secret *sentry.ProjectSymbolSourceHiddenSecret) bool {
+		return secret != nil && secret.HiddenSecret != nil && *secret.HiddenSecret
+	}
+	return map[string]bool{
+		"app_store_connect.private_key": isSet(source.AppConnectPrivateKey),
+		"http.password":                 isSet(source.Password),
+		"gcs.private_key":               isSet(source.PrivateKey),
+		"s3.secret_key":                 isSet(source.SecretKey),
+	}
+}
+
 type ProjectSymbolSourcesResourceLayoutModel struct {
 	Type   types.String `tfsdk:"type"`
 	Casing types.String `tfsdk:"casing"`
@@ -100,7 +290,10 @@ func (r *ProjectSymbolSourcesResource) Metadata(ctx context.Context, req resourc
 
 func (r *ProjectSymbolSourcesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Sentry Project Symbol Source. See the [Sentry documentation](https://docs.sentry.io/api/projects/add-a-symbol-source-to-a-project/) for more information.",
+		MarkdownDescription: "Sentry Project Symbol Source. See the [Sentry documentation](https://docs.sentry.io/api/projects/add-a-symbol-source-to-a-project/) for more information.\n\n" +
+			"The settings of the source go in the block matching its `type`: `app_store_connect`, `http`, `gcs` or `s3`.\n\n" +
+			"Secrets cannot be read back from Sentry. A salted hash of each applied secret is kept in the private state instead, to warn when a secret is about to be rotated and when the source was modified outside of Terraform.",
+		Version: 1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -165,78 +358,179 @@ func (r *ProjectSymbolSourcesResource) Schema(ctx context.Context, req resource.
 					},
 				},
 			},
-			"app_connect_issuer": schema.StringAttribute{
-				Description: "The App Store Connect Issuer ID. Required for AppStoreConnect sources, invalid for all others.",
-				Optional:    true,
-			},
-			"app_connect_private_key": schema.StringAttribute{
-				Description: "The App Store Connect API Private Key. Required for AppStoreConnect sources, invalid for all others.",
-				Optional:    true,
-				Sensitive:   true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+		},
+		Blocks: map[string]schema.Block{
+			"app_store_connect": schema.SingleNestedBlock{
+				Description: "The settings of an App Store Connect source. Required for AppStoreConnect sources, invalid for all others.",
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						Description: "The App Store Connect Issuer ID.",
+						Required:    true,
+					},
+					"private_key": schema.StringAttribute{
+						Description: "The App Store Connect API Private Key.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"app_id": schema.StringAttribute{
+						Description: "The App Store Connect App ID.",
+						Required:    true,
+					},
 				},
 			},
-			"app_id": schema.StringAttribute{
-				Description: "The App Store Connect App ID. Required for AppStoreConnect sources, invalid for all others.",
-				Optional:    true,
-			},
-			"url": schema.StringAttribute{
-				Description: "The source's URL. Optional for HTTP sources, invalid for all others.",
-				Optional:    true,
-			},
-			"username": schema.StringAttribute{
-				Description: "The user name for accessing the source. Optional for HTTP sources, invalid for all others.",
-				Optional:    true,
-			},
-			"password": schema.StringAttribute{
-				Description: "The password for accessing the source. Optional for HTTP sources, invalid for all others.",
-				Optional:    true,
-				Sensitive:   true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+			"http": schema.SingleNestedBlock{
+				Description: "The settings of a SymbolServer (HTTP) source. Required for HTTP sources, invalid for all others.",
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "The source's URL.",
+						Required:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "The user name for accessing the source.",
+						Optional:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The password for accessing the source.",
+						Optional:    true,
+						Sensitive:   true,
+					},
 				},
 			},
-			"bucket": schema.StringAttribute{
-				Description: "The GCS or S3 bucket where the source resides. Required for GCS and S3 sourcse, invalid for HTTP and AppStoreConnect sources.",
-				Optional:    true,
-			},
-			"region": schema.StringAttribute{
-				Description: "The source's S3 region. Required for S3 sources, invalid for all others.",
-				Optional:    true,
-			},
-			"access_key": schema.StringAttribute{
-				Description: "The AWS Access Key.Required for S3 sources, invalid for all others.",
-				Optional:    true,
-			},
-			"secret_key": schema.StringAttribute{
-				Description: "The AWS Secret Access Key.Required for S3 sources, invalid for all others.",
-				Optional:    true,
-				Sensitive:   true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+			"gcs": schema.SingleNestedBlock{
+				Description: "The settings of a Google Cloud Storage source. Required for GCS sources, invalid for all others.",
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Description: "The GCS bucket where the source resides.",
+						Required:    true,
+					},
+					"prefix": schema.StringAttribute{
+						Description: "The GCS prefix.",
+						Optional:    true,
+					},
+					"client_email": schema.StringAttribute{
+						Description: "The GCS email address for authentication.",
+						Required:    true,
+					},
+					"private_key": schema.StringAttribute{
+						Description: "The GCS private key.",
+						Required:    true,
+						Sensitive:   true,
+					},
 				},
 			},
-			"prefix": schema.StringAttribute{
-				Description: "The GCS or S3 prefix. Optional for GCS and S3 sourcse, invalid for HTTP and AppStoreConnect sources.",
-				Optional:    true,
-			},
-			"client_email": schema.StringAttribute{
-				Description: "The GCS email address for authentication. Required for GCS sources, invalid for all others.",
-				Optional:    true,
-			},
-			"private_key": schema.StringAttribute{
-				Description: "The GCS private key. Required for GCS sources, invalid for all others.",
-				Optional:    true,
-				Sensitive:   true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+			"s3": schema.SingleNestedBlock{
+				Description: "The settings of an Amazon S3 source. Required for S3 sources, invalid for all others.",
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Description: "The S3 bucket where the source resides.",
+						Required:    true,
+					},
+					"prefix": schema.StringAttribute{
+						Description: "The S3 prefix.",
+						Optional:    true,
+					},
+					"region": schema.StringAttribute{
+						Description: "The source's S3 region.",
+						Required:    true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The AWS Access Key.",
+						Required:    true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The AWS Secret Access Key.",
+						Required:    true,
+						Sensitive:   true,
+					},
 				},
 			},
 		},
 	}
 }
 
+// ValidateConfig checks that only the settings matching the type of the
+// source are set, as Sentry silently ignores the others.
+func (r *ProjectSymbolSourcesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var sourceType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &sourceType)...)
+	if resp.Diagnostics.HasError() || sourceType.IsNull() || sourceType.IsUnknown() {
+		return
+	}
+
+	expected, ok := symbolSourceTypeAttributes[sourceType.ValueString()]
+	if !ok {
+		return
+	}
+
+	for _, attribute := range []string{"app_store_connect", "http", "gcs", "s3"} {
+		var value types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value.IsUnknown() {
+			continue
+		}
+
+		switch {
+		case attribute == expected && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s must be set for %s sources.", attribute, sourceType.ValueString()),
+			)
+		case attribute != expected && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s cannot be set for %s sources, use %s instead.", attribute, sourceType.ValueString(), expected),
+			)
+		}
+	}
+
+	var layout types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("layout"), &layout)...)
+	if resp.Diagnostics.HasError() || layout.IsUnknown() {
+		return
+	}
+
+	switch {
+	case sourceType.ValueString() == "appStoreConnect" && !layout.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("layout"),
+			"Invalid Attribute Combination",
+			"layout cannot be set for appStoreConnect sources.",
+		)
+	case sourceType.ValueString() != "appStoreConnect" && layout.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("layout"),
+			"Missing Attribute Configuration",
+			fmt.Sprintf("layout must be set for %s sources.", sourceType.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan warns about secrets that differ from the ones last applied.
+func (r *ProjectSymbolSourcesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data ProjectSymbolSourcesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, diags := secretHashesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(secretRotationWarnings(hashes, data.secrets())...)
+}
+
 func (r *ProjectSymbolSourcesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ProjectSymbolSourcesResourceModel
 
@@ -246,28 +540,24 @@ func (r *ProjectSymbolSourcesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	fields := data.fields()
 	params := &sentry.CreateProjectSymbolSourceParams{
 		Type:                 data.Type.ValueStringPointer(),
 		Name:                 data.Name.ValueStringPointer(),
-		AppConnectIssuer:     data.AppConnectIssuer.ValueStringPointer(),
-		AppConnectPrivateKey: data.AppConnectPrivateKey.ValueStringPointer(),
-		AppId:                data.AppId.ValueStringPointer(),
-		Url:                  data.Url.ValueStringPointer(),
-		Username:             data.Username.ValueStringPointer(),
-		Password:             data.Password.ValueStringPointer(),
-		Bucket:               data.Bucket.ValueStringPointer(),
-		Region:               data.Region.ValueStringPointer(),
-		AccessKey:            data.AccessKey.ValueStringPointer(),
-		SecretKey:            data.SecretKey.ValueStringPointer(),
-		Prefix:               data.Prefix.ValueStringPointer(),
-		ClientEmail:          data.ClientEmail.ValueStringPointer(),
-		PrivateKey:           data.PrivateKey.ValueStringPointer(),
-	}
-	if data.Layout != nil {
-		params.Layout = &sentry.ProjectSymbolSourceLayout{
-			Type:   data.Layout.Type.ValueStringPointer(),
-			Casing: data.Layout.Casing.ValueStringPointer(),
-		}
+		Layout:               data.layout(),
+		AppConnectIssuer:     fields.AppConnectIssuer,
+		AppConnectPrivateKey: fields.AppConnectPrivateKey,
+		AppId:                fields.AppId,
+		Url:                  fields.Url,
+		Username:             fields.Username,
+		Password:             fields.Password,
+		Bucket:               fields.Bucket,
+		Region:               fields.Region,
+		AccessKey:            fields.AccessKey,
+		SecretKey:            fields.SecretKey,
+		Prefix:               fields.Prefix,
+		ClientEmail:          fields.ClientEmail,
+		PrivateKey:           fields.PrivateKey,
 	}
 
 	source, _, err := r.client.ProjectSymbolSources.Create(
@@ -286,7 +576,14 @@ func (r *ProjectSymbolSourcesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	hashes, err := secretHashes{}.update(data.secrets())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error hashing secrets: %s", err.Error()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeySecretHashes, must.Get(json.Marshal(hashes)))...)
 }
 
 func (r *ProjectSymbolSourcesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -323,12 +620,38 @@ func (r *ProjectSymbolSourcesResource) Read(ctx context.Context, req resource.Re
 	}
 
 	source := sources[0]
+	prior := data.withoutSecrets()
 
 	if err := data.Fill(*source); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling project symbol source: %s", err.Error()))
 		return
 	}
 
+	hashes, diags := secretHashesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secrets cannot be compared, but a source that was changed outside
+	// of Terraform may have had its secrets changed too. Hashes of secrets
+	// the source no longer has are dropped on the next apply.
+	modified := !reflect.DeepEqual(prior, data.withoutSecrets())
+	isSet := remoteSecrets(*source)
+	for name := range data.secrets() {
+		if _, ok := hashes[name]; !ok {
+			continue
+		}
+
+		switch {
+		case !isSet[name]:
+			resp.Diagnostics.Append(unknownRemoteSecretWarning(name, "Sentry reports that the secret was removed"))
+			data.clearSecret(name)
+		case modified:
+			resp.Diagnostics.Append(unknownRemoteSecretWarning(name, "The symbol source was modified outside of Terraform"))
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -341,29 +664,25 @@ func (r *ProjectSymbolSourcesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	fields := data.fields()
 	params := &sentry.UpdateProjectSymbolSourceParams{
 		ID:                   data.Id.ValueStringPointer(),
 		Type:                 data.Type.ValueStringPointer(),
 		Name:                 data.Name.ValueStringPointer(),
-		AppConnectIssuer:     data.AppConnectIssuer.ValueStringPointer(),
-		AppConnectPrivateKey: data.AppConnectPrivateKey.ValueStringPointer(),
-		AppId:                data.AppId.ValueStringPointer(),
-		Url:                  data.Url.ValueStringPointer(),
-		Username:             data.Username.ValueStringPointer(),
-		Password:             data.Password.ValueStringPointer(),
-		Bucket:               data.Bucket.ValueStringPointer(),
-		Region:               data.Region.ValueStringPointer(),
-		AccessKey:            data.AccessKey.ValueStringPointer(),
-		SecretKey:            data.SecretKey.ValueStringPointer(),
-		Prefix:               data.Prefix.ValueStringPointer(),
-		ClientEmail:          data.ClientEmail.ValueStringPointer(),
-		PrivateKey:           data.PrivateKey.ValueStringPointer(),
-	}
-	if data.Layout != nil {
-		params.Layout = &sentry.ProjectSymbolSourceLayout{
-			Type:   data.Layout.Type.ValueStringPointer(),
-			Casing: data.Layout.Casing.ValueStringPointer(),
-		}
+		Layout:               data.layout(),
+		AppConnectIssuer:     fields.AppConnectIssuer,
+		AppConnectPrivateKey: fields.AppConnectPrivateKey,
+		AppId:                fields.AppId,
+		Url:                  fields.Url,
+		Username:             fields.Username,
+		Password:             fields.Password,
+		Bucket:               fields.Bucket,
+		Region:               fields.Region,
+		AccessKey:            fields.AccessKey,
+		SecretKey:            fields.SecretKey,
+		Prefix:               fields.Prefix,
+		ClientEmail:          fields.ClientEmail,
+		PrivateKey:           fields.PrivateKey,
 	}
 
 	source, _, err := r.client.ProjectSymbolSources.Update(
@@ -383,7 +702,20 @@ func (r *ProjectSymbolSourcesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	priorHashes, diags := secretHashesFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, err := priorHashes.update(data.secrets())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error hashing secrets: %s", err.Error()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeySecretHashes, must.Get(json.Marshal(hashes)))...)
 }
 
 func (r *ProjectSymbolSourcesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -427,3 +759,155 @@ func (r *ProjectSymbolSourcesResource) ImportState(ctx context.Context, req reso
 		ctx, path.Root("id"), symbolSourceId,
 	)...)
 }
+
+func (r *ProjectSymbolSourcesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	type modelV0 struct {
+		Id                   types.String                             `tfsdk:"id"`
+		Organization         types.String                             `tfsdk:"organization"`
+		Project              types.String                             `tfsdk:"project"`
+		Type                 types.String                             `tfsdk:"type"`
+		Name                 types.String                             `tfsdk:"name"`
+		Layout               *ProjectSymbolSourcesResourceLayoutModel `tfsdk:"layout"`
+		AppConnectIssuer     types.String                             `tfsdk:"app_connect_issuer"`
+		AppConnectPrivateKey types.String                             `tfsdk:"app_connect_private_key"`
+		AppId                types.String                             `tfsdk:"app_id"`
+		Url                  types.String                             `tfsdk:"url"`
+		Username             types.String                             `tfsdk:"username"`
+		Password             types.String                             `tfsdk:"password"`
+		Bucket               types.String                             `tfsdk:"bucket"`
+		Region               types.String                             `tfsdk:"region"`
+		AccessKey            types.String                             `tfsdk:"access_key"`
+		SecretKey            types.String                             `tfsdk:"secret_key"`
+		Prefix               types.String                             `tfsdk:"prefix"`
+		ClientEmail          types.String                             `tfsdk:"client_email"`
+		PrivateKey           types.String                             `tfsdk:"private_key"`
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"organization": schema.StringAttribute{
+						Required: true,
+					},
+					"project": schema.StringAttribute{
+						Required: true,
+					},
+					"type": schema.StringAttribute{
+						Required: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"layout": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Required: true,
+							},
+							"casing": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+					"app_connect_issuer": schema.StringAttribute{
+						Optional: true,
+					},
+					"app_connect_private_key": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"app_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"url": schema.StringAttribute{
+						Optional: true,
+					},
+					"username": schema.StringAttribute{
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"bucket": schema.StringAttribute{
+						Optional: true,
+					},
+					"region": schema.StringAttribute{
+						Optional: true,
+					},
+					"access_key": schema.StringAttribute{
+						Optional: true,
+					},
+					"secret_key": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"prefix": schema.StringAttribute{
+						Optional: true,
+					},
+					"client_email": schema.StringAttribute{
+						Optional: true,
+					},
+					"private_key": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData modelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedStateData := ProjectSymbolSourcesResourceModel{
+					Id:           priorStateData.Id,
+					Organization: priorStateData.Organization,
+					Project:      priorStateData.Project,
+					Type:         priorStateData.Type,
+					Name:         priorStateData.Name,
+					Layout:       priorStateData.Layout,
+				}
+
+				switch priorStateData.Type.ValueString() {
+				case "appStoreConnect":
+					upgradedStateData.AppStoreConnect = &ProjectSymbolSourcesResourceAppStoreConnectModel{
+						Issuer:     priorStateData.AppConnectIssuer,
+						PrivateKey: priorStateData.AppConnectPrivateKey,
+						AppId:      priorStateData.AppId,
+					}
+				case "http":
+					upgradedStateData.Http = &ProjectSymbolSourcesResourceHttpModel{
+						Url:      priorStateData.Url,
+						Username: priorStateData.Username,
+						Password: priorStateData.Password,
+					}
+				case "gcs":
+					upgradedStateData.Gcs = &ProjectSymbolSourcesResourceGcsModel{
+						Bucket:      priorStateData.Bucket,
+						Prefix:      priorStateData.Prefix,
+						ClientEmail: priorStateData.ClientEmail,
+						PrivateKey:  priorStateData.PrivateKey,
+					}
+				case "s3":
+					upgradedStateData.S3 = &ProjectSymbolSourcesResourceS3Model{
+						Bucket:    priorStateData.Bucket,
+						Prefix:    priorStateData.Prefix,
+						Region:    priorStateData.Region,
+						AccessKey: priorStateData.AccessKey,
+						SecretKey: priorStateData.SecretKey,
+					}
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgradedStateData)...)
+			},
+		},
+	}
+}
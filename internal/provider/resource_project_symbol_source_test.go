@@ -1,9 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
@@ -19,6 +28,14 @@ func TestAccProjectSymbolSourceResource(t *testing.T) {
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectSymbolSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`gcs cannot be set for s3 sources`),
+			},
+			{
+				Config:      testAccProjectSymbolSourceMissingLayoutConfig,
+				ExpectError: regexp.MustCompile(`layout must be set for http sources`),
+			},
 			{
 				Config: testAccProjectSymbolSourceConfig(team, project, "s3"),
 				Check: resource.ComposeTestCheckFunc(
@@ -29,10 +46,10 @@ func TestAccProjectSymbolSourceResource(t *testing.T) {
 					resource.TestCheckResourceAttr(rn, "layout.%", "2"),
 					resource.TestCheckResourceAttr(rn, "layout.type", "native"),
 					resource.TestCheckResourceAttr(rn, "layout.casing", "default"),
-					resource.TestCheckResourceAttr(rn, "bucket", "bucket"),
-					resource.TestCheckResourceAttr(rn, "region", "us-east-1"),
-					resource.TestCheckResourceAttr(rn, "access_key", "access_key"),
-					resource.TestCheckResourceAttr(rn, "secret_key", "secret_key"),
+					resource.TestCheckResourceAttr(rn, "s3.bucket", "bucket"),
+					resource.TestCheckResourceAttr(rn, "s3.region", "us-east-1"),
+					resource.TestCheckResourceAttr(rn, "s3.access_key", "access_key"),
+					resource.TestCheckResourceAttr(rn, "s3.secret_key", "secret_key"),
 				),
 			},
 			{
@@ -45,10 +62,10 @@ func TestAccProjectSymbolSourceResource(t *testing.T) {
 					resource.TestCheckResourceAttr(rn, "layout.%", "2"),
 					resource.TestCheckResourceAttr(rn, "layout.type", "native"),
 					resource.TestCheckResourceAttr(rn, "layout.casing", "default"),
-					resource.TestCheckResourceAttr(rn, "bucket", "bucket"),
-					resource.TestCheckResourceAttr(rn, "region", "us-east-1"),
-					resource.TestCheckResourceAttr(rn, "access_key", "access_key"),
-					resource.TestCheckResourceAttr(rn, "secret_key", "secret_key"),
+					resource.TestCheckResourceAttr(rn, "s3.bucket", "bucket"),
+					resource.TestCheckResourceAttr(rn, "s3.region", "us-east-1"),
+					resource.TestCheckResourceAttr(rn, "s3.access_key", "access_key"),
+					resource.TestCheckResourceAttr(rn, "s3.secret_key", "secret_key"),
 				),
 			},
 			{
@@ -65,7 +82,7 @@ func TestAccProjectSymbolSourceResource(t *testing.T) {
 					return buildThreePartID(organization, project, sourceId), nil
 				},
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"secret_key"},
+				ImportStateVerifyIgnore: []string{"s3.secret_key"},
 			},
 		},
 	})
@@ -95,10 +112,190 @@ resource "sentry_project_symbol_source" "test" {
 		type   = "native"
 		casing = "default"
 	}
-	bucket       = "bucket"
-	region       = "us-east-1"
-	access_key   = "access_key"
-	secret_key   = "secret_key"
+	s3 {
+		bucket     = "bucket"
+		region     = "us-east-1"
+		access_key = "access_key"
+		secret_key = "secret_key"
+	}
 }
 `, teamName, projectName, name)
 }
+
+var testAccProjectSymbolSourceInvalidConfig = `
+resource "sentry_project_symbol_source" "test" {
+	organization = "organization"
+	project      = "project"
+	type         = "s3"
+	name         = "invalid"
+	layout       = {
+		type   = "native"
+		casing = "default"
+	}
+	gcs {
+		bucket       = "bucket"
+		client_email = "user@project.iam.gserviceaccount.com"
+		private_key  = "private_key"
+	}
+}
+`
+
+var testAccProjectSymbolSourceMissingLayoutConfig = `
+resource "sentry_project_symbol_source" "test" {
+	organization = "organization"
+	project      = "project"
+	type         = "http"
+	name         = "invalid"
+	http {
+		url = "https://example.com"
+	}
+}
+`
+
+func TestProjectSymbolSourcesResourceUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &ProjectSymbolSourcesResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	expectedModel := func(sourceType string) ProjectSymbolSourcesResourceModel {
+		return ProjectSymbolSourcesResourceModel{
+			Id:           types.StringValue("1"),
+			Organization: types.StringValue("organization"),
+			Project:      types.StringValue("project"),
+			Type:         types.StringValue(sourceType),
+			Name:         types.StringValue("source"),
+			Layout: &ProjectSymbolSourcesResourceLayoutModel{
+				Type:   types.StringValue("native"),
+				Casing: types.StringValue("default"),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		attributes map[string]string
+		expected   func(m *ProjectSymbolSourcesResourceModel)
+	}{
+		"appStoreConnect": {
+			attributes: map[string]string{
+				"app_connect_issuer":      "issuer",
+				"app_connect_private_key": "private_key",
+				"app_id":                  "app_id",
+			},
+			expected: func(m *ProjectSymbolSourcesResourceModel) {
+				m.AppStoreConnect = &ProjectSymbolSourcesResourceAppStoreConnectModel{
+					Issuer:     types.StringValue("issuer"),
+					PrivateKey: types.StringValue("private_key"),
+					AppId:      types.StringValue("app_id"),
+				}
+			},
+		},
+		"http": {
+			attributes: map[string]string{
+				"url":      "https://example.com",
+				"username": "username",
+				"password": "password",
+			},
+			expected: func(m *ProjectSymbolSourcesResourceModel) {
+				m.Http = &ProjectSymbolSourcesResourceHttpModel{
+					Url:      types.StringValue("https://example.com"),
+					Username: types.StringValue("username"),
+					Password: types.StringValue("password"),
+				}
+			},
+		},
+		"gcs": {
+			attributes: map[string]string{
+				"bucket":       "bucket",
+				"prefix":       "prefix",
+				"client_email": "user@project.iam.gserviceaccount.com",
+				"private_key":  "private_key",
+			},
+			expected: func(m *ProjectSymbolSourcesResourceModel) {
+				m.Gcs = &ProjectSymbolSourcesResourceGcsModel{
+					Bucket:      types.StringValue("bucket"),
+					Prefix:      types.StringValue("prefix"),
+					ClientEmail: types.StringValue("user@project.iam.gserviceaccount.com"),
+					PrivateKey:  types.StringValue("private_key"),
+				}
+			},
+		},
+		"s3": {
+			attributes: map[string]string{
+				"bucket":     "bucket",
+				"prefix":     "prefix",
+				"region":     "us-east-1",
+				"access_key": "access_key",
+				"secret_key": "secret_key",
+			},
+			expected: func(m *ProjectSymbolSourcesResourceModel) {
+				m.S3 = &ProjectSymbolSourcesResourceS3Model{
+					Bucket:    types.StringValue("bucket"),
+					Prefix:    types.StringValue("prefix"),
+					Region:    types.StringValue("us-east-1"),
+					AccessKey: types.StringValue("access_key"),
+					SecretKey: types.StringValue("secret_key"),
+				}
+			},
+		},
+	}
+
+	for sourceType, tc := range testCases {
+		sourceType, tc := sourceType, tc
+		t.Run(sourceType, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			prior := tfsdk.State{
+				Schema: *upgrader.PriorSchema,
+				Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+			}
+			attributes := map[string]string{
+				"id":           "1",
+				"organization": "organization",
+				"project":      "project",
+				"type":         sourceType,
+				"name":         "source",
+			}
+			for name, value := range tc.attributes {
+				attributes[name] = value
+			}
+			for name, value := range attributes {
+				diags.Append(prior.SetAttribute(ctx, path.Root(name), value)...)
+			}
+			diags.Append(prior.SetAttribute(ctx, path.Root("layout"), &ProjectSymbolSourcesResourceLayoutModel{
+				Type:   types.StringValue("native"),
+				Casing: types.StringValue("default"),
+			})...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error setting the prior state: %v", diags)
+			}
+
+			resp := fwresource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{State: &prior}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error upgrading the state: %v", resp.Diagnostics)
+			}
+
+			var got ProjectSymbolSourcesResourceModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("unexpected error reading the upgraded state: %v", diags)
+			}
+
+			expected := expectedModel(sourceType)
+			tc.expected(&expected)
+			if diff := cmp.Diff(expected, got); diff != "" {
+				t.Errorf("unexpected upgraded state (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
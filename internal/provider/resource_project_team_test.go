@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccProjectTeamResource(t *testing.T) {
+	rn := "sentry_project_team.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTeamResourceConfig(team, project),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(fmt.Sprintf("%s/%s/%s", acctest.TestOrganization, project, team+"-2"))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("team"), knownvalue.StringExact(team+"-2")),
+					// The project only tracks the teams it manages.
+					statecheck.ExpectKnownValue("sentry_project.test", tfjsonpath.New("teams"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(team),
+					})),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Refreshing the project does not pick up the team added by
+				// sentry_project_team.
+				Config:             testAccProjectTeamResourceConfig(team, project),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccProjectTeamResourceConfig(teamName, projectName string) string {
+	return testAccProjectResourceConfigTeams(teamName, teamName+"-2", projectName, "[sentry_team.test.id]", `
+	teams_authoritative = false
+`) + `
+resource "sentry_project_team" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	team         = sentry_team.test_2.id
+}
+`
+}
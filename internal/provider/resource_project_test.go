@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 
 	"github.com/canva/terraform-provider-sentry/internal/acctest"
 
@@ -54,6 +59,368 @@ func init() {
 	})
 }
 
+func TestAccProjectResource(t *testing.T) {
+	rn := "sentry_project.test"
+	team1 := acctest.RandomWithPrefix("tf-team")
+	team2 := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	checks := func(projectName string, teams ...string) []statecheck.StateCheck {
+		teamValues := []knownvalue.Check{}
+		for _, team := range teams {
+			teamValues = append(teamValues, knownvalue.StringExact(team))
+		}
+		return []statecheck.StateCheck{
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(project)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams"), knownvalue.SetExact(teamValues)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams_authoritative"), knownvalue.Bool(true)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(projectName)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("slug"), knownvalue.StringExact(project)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("platform"), knownvalue.StringExact("go")),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("internal_id"), knownvalue.NotNull()),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:            testAccProjectResourceConfigTeams(team1, team2, project, "[sentry_team.test.id]", ""),
+				ConfigStateChecks: checks(project, team1),
+			},
+			{
+				Config:            testAccProjectResourceConfigTeams(team1, team2, project+"-renamed", "[sentry_team.test.id, sentry_team.test_2.id]", ""),
+				ConfigStateChecks: checks(project+"-renamed", team1, team2),
+			},
+			{
+				Config:            testAccProjectResourceConfigTeams(team1, team2, project+"-renamed", "[sentry_team.test_2.id]", ""),
+				ConfigStateChecks: checks(project+"-renamed", team2),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectImportStateIdFunc(rn),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_slugRenamedOutsideTerraform(t *testing.T) {
+	rn := "sentry_project.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+	var internalId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(team, project),
+				Check: func(s *terraform.State) error {
+					internalId = s.RootModule().Resources[rn].Primary.Attributes["internal_id"]
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					_, _, err := acctest.SharedClient.Projects.Update(context.Background(), acctest.TestOrganization, project, &sentry.UpdateProjectParams{
+						Slug: project + "-moved",
+					})
+					if err != nil {
+						t.Fatalf("failed to rename project: %s", err)
+					}
+				},
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", project+"-moved"),
+					resource.TestCheckResourceAttr(rn, "slug", project+"-moved"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources[rn].Primary.Attributes["internal_id"]; got != internalId {
+							return fmt.Errorf("expected internal_id %s, got %s", internalId, got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_options(t *testing.T) {
+	rn := "sentry_project.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigTeams(team, team+"-2", project, "[sentry_team.test.id]", `
+	scrub_data          = true
+	sensitive_fields    = ["password", "secret"]
+	safe_fields         = ["username"]
+	scrub_ip_address    = true
+	store_crash_reports = 5
+	subject_prefix      = "[Sentry]"
+	highlight_tags      = ["handled", "level"]
+	verify_ssl          = true
+	token_header        = "X-Sentry-Token"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("scrub_data"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sensitive_fields"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("password"),
+						knownvalue.StringExact("secret"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("safe_fields"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("username"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("scrub_ip_address"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("store_crash_reports"), knownvalue.Int64Exact(5)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("subject_prefix"), knownvalue.StringExact("[Sentry]")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("highlight_tags"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("handled"),
+						knownvalue.StringExact("level"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("verify_ssl"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("token_header"), knownvalue.StringExact("X-Sentry-Token")),
+				},
+			},
+			{
+				Config: testAccProjectResourceConfigTeams(team, team+"-2", project, "[sentry_team.test.id]", `
+	scrub_data       = false
+	sensitive_fields = []
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("scrub_data"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sensitive_fields"), knownvalue.SetSizeExact(0)),
+					// Options that are no longer configured are left as is.
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("scrub_ip_address"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("subject_prefix"), knownvalue.StringExact("[Sentry]")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_defaults(t *testing.T) {
+	rn := "sentry_project.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigTeams(team, team+"-2", project, "[sentry_team.test.id]", `
+	default_key   = false
+	default_rules = false
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectKeyCount(rn, 0),
+					testAccCheckProjectRuleCount(rn, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_removeDefaults(t *testing.T) {
+	rn := "sentry_project.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	// A key and a rule named like the defaults, which must survive the removal
+	// of the defaults.
+	extras := `
+resource "sentry_key" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "Default"
+}
+
+resource "sentry_issue_alert" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	name         = "Send a notification for new issues"
+	action_match = "any"
+	filter_match = "any"
+	frequency    = 30
+
+	conditions = jsonencode([
+		{ id = "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition" },
+	])
+	actions = jsonencode([
+		{ id = "sentry.mail.actions.NotifyEmailAction", targetType = "IssueOwners", fallthroughType = "ActiveMembers" },
+	])
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigTeams(team, team+"-2", project, "[sentry_team.test.id]", "") + extras,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectKeyCount(rn, 2),
+					testAccCheckProjectRuleCount(rn, 2),
+				),
+			},
+			{
+				Config: testAccProjectResourceConfigTeams(team, team+"-2", project, "[sentry_team.test.id]", `
+	remove_default_key  = true
+	remove_default_rule = true
+`) + extras,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectKeyCount(rn, 1),
+					testAccCheckProjectRuleCount(rn, 1),
+					resource.TestCheckResourceAttr("sentry_key.test", "name", "Default"),
+					resource.TestCheckResourceAttr("sentry_issue_alert.test", "name", "Send a notification for new issues"),
+				),
+			},
+			{
+				// Later applies leave the remaining key and rule alone.
+				Config: testAccProjectResourceConfigTeams(team, team+"-2", project+"-renamed", "[sentry_team.test.id]", `
+	remove_default_key  = true
+	remove_default_rule = true
+`) + extras,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectKeyCount(rn, 1),
+					testAccCheckProjectRuleCount(rn, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_upgradeFromVersion0(t *testing.T) {
+	rn := "sentry_project.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	config := testAccTeamResourceConfig(team) + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	teams        = [sentry_team.test.id]
+	name         = "%[1]s"
+	platform     = "go"
+}
+`, project)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					acctest.ProviderName: {
+						Source:            "jianyuan/sentry",
+						VersionConstraint: "0.12.3",
+					},
+				},
+				Config: config,
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(team),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("internal_id"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckProjectDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_project" {
+			continue
+		}
+
+		ctx := context.Background()
+		project, resp, err := acctest.SharedClient.Projects.Get(ctx, rs.Primary.Attributes["organization"], rs.Primary.ID)
+		if err == nil && project != nil && project.Status != "pending_deletion" {
+			return fmt.Errorf("project %s still exists", rs.Primary.ID)
+		}
+		if err != nil && resp.StatusCode != 403 && resp.StatusCode != 404 {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckProjectKeyCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		keys, _, err := acctest.SharedClient.ProjectKeys.List(context.Background(), rs.Primary.Attributes["organization"], rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		if len(keys) != expected {
+			return fmt.Errorf("expected %d keys, got %d", expected, len(keys))
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectRuleCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		rules, _, err := acctest.SharedClient.IssueAlerts.List(context.Background(), rs.Primary.Attributes["organization"], rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		if len(rules) != expected {
+			return fmt.Errorf("expected %d issue alerts, got %d", expected, len(rules))
+		}
+		return nil
+	}
+}
+
+func testAccProjectImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return buildTwoPartID(rs.Primary.Attributes["organization"], rs.Primary.ID), nil
+	}
+}
+
+func testAccProjectResourceConfigTeams(teamName1, teamName2, projectName, teams, extras string) string {
+	return testAccTeamResourceConfig(teamName1) + fmt.Sprintf(`
+resource "sentry_team" "test_2" {
+	organization = data.sentry_organization.test.id
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	teams        = %[3]s
+	name         = "%[2]s"
+	platform     = "go"
+	%[4]s
+}
+`, teamName2, projectName, teams, extras)
+}
+
 func testAccProjectResourceConfig(teamName, projectName string) string {
 	return testAccTeamResourceConfig(teamName) + fmt.Sprintf(`
 resource "sentry_project" "test" {
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+)
+
+var _ resource.Resource = &ReleaseThresholdResource{}
+var _ resource.ResourceWithConfigure = &ReleaseThresholdResource{}
+var _ resource.ResourceWithImportState = &ReleaseThresholdResource{}
+
+var releaseThresholdTypes = []string{
+	"total_error_count",
+	"new_issue_count",
+	"unhandled_issue_count",
+	"regressed_issue_count",
+	"failure_rate",
+	"crash_free_session_rate",
+	"crash_free_user_rate",
+}
+
+var releaseThresholdTriggerTypes = []string{"over", "under"}
+
+func NewReleaseThresholdResource() resource.Resource {
+	return &ReleaseThresholdResource{}
+}
+
+type ReleaseThresholdResource struct {
+	baseResource
+}
+
+type ReleaseThresholdResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Organization    types.String `tfsdk:"organization"`
+	Project         types.String `tfsdk:"project"`
+	ThresholdType   types.String `tfsdk:"threshold_type"`
+	TriggerType     types.String `tfsdk:"trigger_type"`
+	Value           types.Int64  `tfsdk:"value"`
+	WindowInSeconds types.Int64  `tfsdk:"window_in_seconds"`
+	Environment     types.String `tfsdk:"environment"`
+}
+
+func (m *ReleaseThresholdResourceModel) Fill(organization string, project string, threshold sentryclient.ReleaseThreshold) error {
+	m.Id = types.StringValue(threshold.ID)
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.ThresholdType = types.StringValue(threshold.ThresholdType)
+	m.TriggerType = types.StringValue(threshold.TriggerType)
+	m.Value = types.Int64Value(threshold.Value)
+	m.WindowInSeconds = types.Int64Value(threshold.WindowInSeconds)
+	if threshold.Environment != nil {
+		m.Environment = types.StringValue(threshold.Environment.Name)
+	} else {
+		m.Environment = types.StringNull()
+	}
+
+	return nil
+}
+
+func (m ReleaseThresholdResourceModel) params() *sentryclient.ReleaseThresholdParams {
+	return &sentryclient.ReleaseThresholdParams{
+		ThresholdType:   m.ThresholdType.ValueString(),
+		TriggerType:     m.TriggerType.ValueString(),
+		Value:           m.Value.ValueInt64(),
+		WindowInSeconds: m.WindowInSeconds.ValueInt64(),
+		Environment:     m.Environment.ValueStringPointer(),
+	}
+}
+
+func (r *ReleaseThresholdResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release_threshold"
+}
+
+func (r *ReleaseThresholdResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Release Threshold resource. A release is healthy while none of the thresholds of its project are breached in the window after it is deployed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the project belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"threshold_type": schema.StringAttribute{
+				MarkdownDescription: "The metric to check. One of `" + strings.Join(releaseThresholdTypes, "`, `") + "`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(releaseThresholdTypes...),
+				},
+			},
+			"trigger_type": schema.StringAttribute{
+				MarkdownDescription: "Whether the threshold is breached when the metric goes `over` or `under` the value.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(releaseThresholdTriggerTypes...),
+				},
+			},
+			"value": schema.Int64Attribute{
+				MarkdownDescription: "The value of the threshold. Rates are percentages, e.g. `99` for a crash free session rate of 99%.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"window_in_seconds": schema.Int64Attribute{
+				MarkdownDescription: "How long after the release is deployed the threshold is checked, in seconds.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment the threshold applies to. Applies to all environments if not set. Changing this forces a new resource, as the environment cannot be removed from an existing threshold.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReleaseThresholdResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReleaseThresholdResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating release threshold", map[string]interface{}{
+		"org":           data.Organization.ValueString(),
+		"project":       data.Project.ValueString(),
+		"thresholdType": data.ThresholdType.ValueString(),
+	})
+	threshold, _, err := sentryclient.CreateReleaseThreshold(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.params())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating release threshold: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *threshold); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReleaseThresholdResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReleaseThresholdResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threshold, apiResp, err := sentryclient.GetReleaseThreshold(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Release threshold not found: %s", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading release threshold: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *threshold); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReleaseThresholdResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReleaseThresholdResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threshold, _, err := sentryclient.UpdateReleaseThreshold(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString(), data.params())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating release threshold: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), *threshold); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Fill error: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReleaseThresholdResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReleaseThresholdResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := sentryclient.DeleteReleaseThreshold(ctx, r.client, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting release threshold: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ReleaseThresholdResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project, id, err := splitThreePartID(req.ID, "organization", "project-slug", "id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("project"), project,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), id,
+	)...)
+}
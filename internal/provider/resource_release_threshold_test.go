@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccReleaseThresholdResource(t *testing.T) {
+	rn := "sentry_release_threshold.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReleaseThresholdResourceConfig(team, project, "over", 100, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("threshold_type"), knownvalue.StringExact("total_error_count")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("trigger_type"), knownvalue.StringExact("over")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("value"), knownvalue.Int64Exact(100)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("window_in_seconds"), knownvalue.Int64Exact(3600)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccReleaseThresholdResourceConfig(team, project, "under", 10, `environment = "production"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("trigger_type"), knownvalue.StringExact("under")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("value"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.StringExact("production")),
+				},
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", rn)
+					}
+					organization := rs.Primary.Attributes["organization"]
+					project := rs.Primary.Attributes["project"]
+					return buildThreePartID(organization, project, rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReleaseThresholdResourceConfig(team, project, "under", 10, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func testAccReleaseThresholdResourceConfig(team, project, triggerType string, value int, extras string) string {
+	return testAccProjectResourceConfig(team, project) + fmt.Sprintf(`
+resource "sentry_release_threshold" "test" {
+	organization      = sentry_project.test.organization
+	project           = sentry_project.test.id
+	threshold_type    = "total_error_count"
+	trigger_type      = "%[1]s"
+	value             = %[2]d
+	window_in_seconds = 3600
+	%[3]s
+}
+`, triggerType, value, extras)
+}
@@ -0,0 +1,428 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+	"github.com/canva/terraform-provider-sentry/internal/sentrytypes"
+)
+
+var _ resource.Resource = &WorkflowResource{}
+var _ resource.ResourceWithConfigure = &WorkflowResource{}
+var _ resource.ResourceWithImportState = &WorkflowResource{}
+var _ resource.ResourceWithMoveState = &WorkflowResource{}
+
+func NewWorkflowResource() resource.Resource {
+	return &WorkflowResource{}
+}
+
+type WorkflowResource struct {
+	baseResource
+}
+
+type WorkflowActionModel struct {
+	Type          types.String          `tfsdk:"type"`
+	IntegrationId types.String          `tfsdk:"integration_id"`
+	Data          sentrytypes.LossyJson `tfsdk:"data"`
+	Config        sentrytypes.LossyJson `tfsdk:"config"`
+}
+
+type WorkflowActionFilterModel struct {
+	LogicType  types.String          `tfsdk:"logic_type"`
+	Conditions []DataConditionModel  `tfsdk:"conditions"`
+	Actions    []WorkflowActionModel `tfsdk:"actions"`
+}
+
+type WorkflowResourceModel struct {
+	Id            types.String                `tfsdk:"id"`
+	Organization  types.String                `tfsdk:"organization"`
+	Name          types.String                `tfsdk:"name"`
+	Enabled       types.Bool                  `tfsdk:"enabled"`
+	Environment   types.String                `tfsdk:"environment"`
+	Frequency     types.Int64                 `tfsdk:"frequency"`
+	DetectorIds   types.Set                   `tfsdk:"detector_ids"`
+	Triggers      *DataConditionGroupModel    `tfsdk:"triggers"`
+	ActionFilters []WorkflowActionFilterModel `tfsdk:"action_filters"`
+}
+
+func (m WorkflowResourceModel) ToAPI(ctx context.Context) (*sentryclient.Workflow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	workflow := &sentryclient.Workflow{
+		Name:          m.Name.ValueString(),
+		Enabled:       m.Enabled.ValueBool(),
+		Environment:   m.Environment.ValueStringPointer(),
+		Config:        sentryclient.WorkflowConfig{Frequency: m.Frequency.ValueInt64Pointer()},
+		ActionFilters: []sentryclient.DataConditionGroup{},
+		DetectorIDs:   []string{},
+	}
+
+	if !m.DetectorIds.IsNull() {
+		diags.Append(m.DetectorIds.ElementsAs(ctx, &workflow.DetectorIDs, false)...)
+	}
+
+	if m.Triggers != nil {
+		triggers, triggersDiags := m.Triggers.ToAPI()
+		diags.Append(triggersDiags...)
+		workflow.Triggers = triggers
+	}
+
+	for _, filterModel := range m.ActionFilters {
+		filter, filterDiags := DataConditionGroupModel{
+			LogicType:  filterModel.LogicType,
+			Conditions: filterModel.Conditions,
+		}.ToAPI()
+		diags.Append(filterDiags...)
+
+		filter.Actions = []sentryclient.WorkflowAction{}
+		for _, actionModel := range filterModel.Actions {
+			action := sentryclient.WorkflowAction{
+				Type:          actionModel.Type.ValueString(),
+				IntegrationID: actionModel.IntegrationId.ValueStringPointer(),
+			}
+
+			var data, config interface{}
+			diags.Append(actionModel.Data.Unmarshal(&data)...)
+			diags.Append(actionModel.Config.Unmarshal(&config)...)
+			action.Data = must.Get(json.Marshal(data))
+			action.Config = must.Get(json.Marshal(config))
+
+			filter.Actions = append(filter.Actions, action)
+		}
+
+		workflow.ActionFilters = append(workflow.ActionFilters, *filter)
+	}
+
+	return workflow, diags
+}
+
+func (m *WorkflowResourceModel) Fill(organization string, workflow sentryclient.Workflow) error {
+	m.Id = types.StringPointerValue(workflow.ID)
+	m.Organization = types.StringValue(organization)
+	m.Name = types.StringValue(workflow.Name)
+	m.Enabled = types.BoolValue(workflow.Enabled)
+	m.Environment = types.StringPointerValue(workflow.Environment)
+	m.Frequency = types.Int64PointerValue(workflow.Config.Frequency)
+
+	if len(workflow.DetectorIDs) > 0 || !m.DetectorIds.IsNull() {
+		detectorIdElements := []attr.Value{}
+		for _, detectorId := range workflow.DetectorIDs {
+			detectorIdElements = append(detectorIdElements, types.StringValue(detectorId))
+		}
+		m.DetectorIds = types.SetValueMust(types.StringType, detectorIdElements)
+	}
+
+	m.Triggers = nil
+	if workflow.Triggers != nil {
+		m.Triggers = &DataConditionGroupModel{}
+		if err := m.Triggers.Fill(*workflow.Triggers); err != nil {
+			return err
+		}
+	}
+
+	m.ActionFilters = nil
+	for _, filter := range workflow.ActionFilters {
+		var group DataConditionGroupModel
+		if err := group.Fill(filter); err != nil {
+			return err
+		}
+
+		filterModel := WorkflowActionFilterModel{
+			LogicType:  group.LogicType,
+			Conditions: group.Conditions,
+		}
+		for _, action := range filter.Actions {
+			filterModel.Actions = append(filterModel.Actions, WorkflowActionModel{
+				Type:          types.StringValue(action.Type),
+				IntegrationId: types.StringPointerValue(action.IntegrationID),
+				Data:          sentrytypes.NewLossyJsonValue(string(action.Data)),
+				Config:        sentrytypes.NewLossyJsonValue(string(action.Config)),
+			})
+		}
+		m.ActionFilters = append(m.ActionFilters, filterModel)
+	}
+
+	return nil
+}
+
+func (r *WorkflowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	actionFilterAttributes := dataConditionGroupAttributes()
+	actionFilterAttributes["actions"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The actions to perform when the triggers and the filter conditions pass.",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the action, e.g. `email`, `slack`, or `pagerduty`.",
+					Required:            true,
+				},
+				"integration_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the integration used by the action.",
+					Optional:            true,
+				},
+				"data": schema.StringAttribute{
+					MarkdownDescription: "Action specific data. In JSON string format.",
+					Required:            true,
+					CustomType:          sentrytypes.LossyJsonType{},
+				},
+				"config": schema.StringAttribute{
+					MarkdownDescription: "Action target configuration, e.g. `{\"targetType\": \"issue_owners\"}`. In JSON string format.",
+					Required:            true,
+					CustomType:          sentrytypes.LossyJsonType{},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Workflow resource, also known as an automation. A workflow performs actions when the issues created by its detectors match its triggers and action filters. Workflows replace issue alerts and the actions of metric alerts.\n\n" +
+			"An existing `sentry_issue_alert` can be moved to a `sentry_workflow` with a `moved` block (Terraform 1.8 or later) instead of being recreated. The state is converted and the ID of the workflow Sentry migrated the issue alert to is looked up on the next refresh. Review the plan afterwards, as the converted condition and action types may need to be adjusted in the configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the resource belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workflow.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workflow is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Only run the workflow for issues in this environment.",
+				Optional:            true,
+			},
+			"frequency": schema.Int64Attribute{
+				MarkdownDescription: "Perform actions at most once every `X` minutes for each issue.",
+				Optional:            true,
+			},
+			"detector_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the detectors whose issues are processed by the workflow.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"triggers": schema.SingleNestedAttribute{
+				MarkdownDescription: "The conditions that start the workflow, e.g. a new or regressed issue.",
+				Required:            true,
+				Attributes:          dataConditionGroupAttributes(),
+			},
+			"action_filters": schema.ListNestedAttribute{
+				MarkdownDescription: "Groups of filter conditions, each with the actions performed when its conditions pass.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: actionFilterAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, _, err := sentryclient.CreateWorkflow(ctx, r.client, data.Organization.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating workflow: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), *workflow); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling workflow: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	movedAlertId, diags := movedAlertIdFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if movedAlertId != "" {
+		workflowId, _, err := sentryclient.GetWorkflowIDForIssueAlert(ctx, r.client, data.Organization.ValueString(), movedAlertId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error looking up the workflow of issue alert %s: %s", movedAlertId, err.Error()))
+			return
+		}
+		data.Id = types.StringValue(workflowId)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyMovedAlertId, nil)...)
+	}
+
+	workflow, apiResp, err := sentryclient.GetWorkflow(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Workflow not found: %s", err.Error()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading workflow: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), *workflow); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling workflow: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, apiResp, err := sentryclient.UpdateWorkflow(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString(), params)
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Workflow not found: %s", err.Error()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating workflow: %s", err.Error()))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), *workflow); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling workflow: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := sentryclient.DeleteWorkflow(ctx, r.client, data.Organization.ValueString(), data.Id.ValueString())
+	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error deleting workflow: %s", err.Error()))
+		return
+	}
+}
+
+func (r *WorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, workflowId, err := splitTwoPartID(req.ID, "organization", "workflow-id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("organization"), organization,
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), workflowId,
+	)...)
+}
+
+func (r *WorkflowResource) MoveState(ctx context.Context) []resource.StateMover {
+	var issueAlertSchema resource.SchemaResponse
+	NewIssueAlertResource().Schema(ctx, resource.SchemaRequest{}, &issueAlertSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &issueAlertSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "sentry_issue_alert" || !isSentryProviderAddress(req.SourceProviderAddress) {
+					return
+				}
+				if req.SourceSchemaVersion != issueAlertSchema.Schema.Version {
+					resp.Diagnostics.AddError(
+						"Unsupported Source Schema Version",
+						fmt.Sprintf("The sentry_issue_alert state has schema version %d. Apply with the current provider before moving it to sentry_workflow.", req.SourceSchemaVersion),
+					)
+					return
+				}
+
+				var source IssueAlertResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data, diags := issueAlertToWorkflow(source)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// The provider is not configured while moving state, so the ID of
+				// the workflow is looked up on the next read.
+				resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, privateKeyMovedAlertId, must.Get(json.Marshal(source.Id.ValueString())))...)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
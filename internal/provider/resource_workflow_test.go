@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/canva/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccWorkflowResource(t *testing.T) {
+	rn := "sentry_workflow.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+	detector := acctest.RandomWithPrefix("tf-detector")
+	workflow := acctest.RandomWithPrefix("tf-workflow")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowResourceConfig(team, project, detector, workflow, 30),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(workflow)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("frequency"), knownvalue.Int64Exact(30)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("detector_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("triggers").AtMapKey("logic_type"), knownvalue.StringExact("any-short")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("action_filters"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("action_filters").AtSliceIndex(0).AtMapKey("actions").AtSliceIndex(0).AtMapKey("type"), knownvalue.StringExact("email")),
+				},
+			},
+			{
+				Config: testAccWorkflowResourceConfig(team, project, detector, workflow, 60),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("frequency"), knownvalue.Int64Exact(60)),
+				},
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", rn)
+					}
+					return buildTwoPartID(rs.Primary.Attributes["organization"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkflowResource_MoveFromIssueAlert(t *testing.T) {
+	rn := "sentry_workflow.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+	alert := acctest.RandomWithPrefix("tf-issue-alert")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueAlertConfigOwner(team, project, alert, "null"),
+			},
+			{
+				Config: testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.id
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_project" "test" {
+	organization = sentry_team.test.organization
+	teams        = [sentry_team.test.id]
+	name         = "%[2]s"
+	platform     = "go"
+}
+
+moved {
+	from = sentry_issue_alert.test
+	to   = sentry_workflow.test
+}
+
+resource "sentry_workflow" "test" {
+	organization = sentry_project.test.organization
+	name         = "%[3]s"
+	frequency    = 30
+
+	triggers = {
+		logic_type = "any-short"
+	}
+
+	action_filters = [
+		{
+			logic_type = "any-short"
+			actions = [
+				{
+					type   = "email"
+					data   = jsonencode({})
+					config = jsonencode({ targetType = "issue_owners" })
+				},
+			]
+		},
+	]
+}
+`, team, project, alert),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(alert)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("frequency"), knownvalue.Int64Exact(30)),
+				},
+			},
+		},
+	})
+}
+
+func testAccWorkflowResourceConfig(teamName string, projectName string, detectorName string, workflowName string, frequency int) string {
+	return testAccDetectorResourceConfig(teamName, projectName, detectorName, 100) + fmt.Sprintf(`
+resource "sentry_workflow" "test" {
+	organization = sentry_detector.test.organization
+	name         = "%[1]s"
+	frequency    = %[2]d
+	detector_ids = [sentry_detector.test.id]
+
+	triggers = {
+		logic_type = "any-short"
+		conditions = [
+			{
+				type             = "first_seen_event"
+				comparison       = jsonencode(true)
+				condition_result = jsonencode(true)
+			},
+		]
+	}
+
+	action_filters = [
+		{
+			logic_type = "all"
+			conditions = [
+				{
+					type             = "level"
+					comparison       = jsonencode({ level = "40", match = "gte" })
+					condition_result = jsonencode(true)
+				},
+			]
+			actions = [
+				{
+					type   = "email"
+					data   = jsonencode({ fallthroughType = "ActiveMembers" })
+					config = jsonencode({ targetType = "issue_owners" })
+				},
+			]
+		},
+	]
+}
+`, workflowName, frequency)
+}
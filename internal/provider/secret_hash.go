@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Write-only secrets cannot be read back from Sentry, so the resources that
+// send them keep a salted hash of each secret as it was last applied. The
+// hash tells whether the configured value changed without keeping another
+// copy of the secret.
+
+// privateKeySecretHashes holds the hashes of the secrets of a resource, keyed
+// by attribute name. Nested attributes are named by their dotted path, e.g.
+// `s3.secret_key`.
+const privateKeySecretHashes = "secret_hashes"
+
+type secretHash struct {
+	Salt string `json:"salt"`
+	Hash string `json:"hash"`
+}
+
+func newSecretHash(secret string) (secretHash, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return secretHash{}, err
+	}
+	return secretHash{
+		Salt: base64.StdEncoding.EncodeToString(salt),
+		Hash: hashSecret(salt, secret),
+	}, nil
+}
+
+func hashSecret(salt []byte, secret string) string {
+	sum := sha256.Sum256(append(slices.Clone(salt), secret...))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// matches reports whether the hash is of the given secret.
+func (h secretHash) matches(secret string) bool {
+	salt, err := base64.StdEncoding.DecodeString(h.Salt)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hashSecret(salt, secret)), []byte(h.Hash)) == 1
+}
+
+type secretHashes map[string]secretHash
+
+// secretPath returns the path of a secret from its dotted name.
+func secretPath(name string) path.Path {
+	steps := strings.Split(name, ".")
+	p := path.Root(steps[0])
+	for _, step := range steps[1:] {
+		p = p.AtName(step)
+	}
+	return p
+}
+
+func secretHashesFromPrivate(ctx context.Context, private privateStateGetter) (secretHashes, diag.Diagnostics) {
+	hashes := secretHashes{}
+
+	value, diags := private.GetKey(ctx, privateKeySecretHashes)
+	if diags.HasError() || len(value) == 0 {
+		return hashes, diags
+	}
+
+	if err := json.Unmarshal(value, &hashes); err != nil {
+		diags.AddError("Private State Error", fmt.Sprintf("Error reading the secret hashes: %s", err.Error()))
+	}
+	return hashes, diags
+}
+
+// update returns the hashes of the applied secrets. The hashes of unchanged
+// secrets are kept as they are and null secrets have no hash.
+func (h secretHashes) update(secrets map[string]types.String) (secretHashes, error) {
+	updated := secretHashes{}
+	for name, secret := range secrets {
+		if secret.IsNull() || secret.IsUnknown() {
+			continue
+		}
+		if hash, ok := h[name]; ok && hash.matches(secret.ValueString()) {
+			updated[name] = hash
+			continue
+		}
+
+		hash, err := newSecretHash(secret.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		updated[name] = hash
+	}
+	return updated, nil
+}
+
+// changed returns the sorted names of the planned secrets that differ from
+// the ones last applied. Secrets without a hash, e.g. of imported resources,
+// are not compared.
+func (h secretHashes) changed(secrets map[string]types.String) []string {
+	var names []string
+	for name, secret := range secrets {
+		hash, ok := h[name]
+		if !ok || secret.IsUnknown() {
+			continue
+		}
+		if secret.IsNull() || !hash.matches(secret.ValueString()) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// secretRotationWarnings warns about each secret that is about to be rotated,
+// so that rotations show up in the plan even though the values are sensitive.
+func secretRotationWarnings(hashes secretHashes, secrets map[string]types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, name := range hashes.changed(secrets) {
+		diags.AddAttributeWarning(
+			secretPath(name),
+			"Secret Rotation",
+			fmt.Sprintf("The configured value of %s differs from the one last applied and will replace it in Sentry.", name),
+		)
+	}
+	return diags
+}
+
+// unknownRemoteSecretWarning warns that a secret in Sentry may not be the one
+// last applied, which cannot be checked as the secret is write-only.
+func unknownRemoteSecretWarning(name string, reason string) diag.Diagnostic {
+	return diag.NewAttributeWarningDiagnostic(
+		secretPath(name),
+		"Unknown Remote Secret",
+		fmt.Sprintf("%s, so the value of %s in Sentry may no longer match the configuration. Apply the configuration to send the configured value again.", reason, name),
+	)
+}
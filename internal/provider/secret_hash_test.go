@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecretHash(t *testing.T) {
+	t.Parallel()
+
+	hash, err := newSecretHash("hunter2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !hash.matches("hunter2") {
+		t.Error("expected the hash to match its secret")
+	}
+	if hash.matches("hunter3") {
+		t.Error("expected the hash not to match another secret")
+	}
+
+	other, err := newSecretHash("hunter2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if other.Salt == hash.Salt || other.Hash == hash.Hash {
+		t.Error("expected the hashes of the same secret to be salted differently")
+	}
+}
+
+func TestSecretHashesUpdate(t *testing.T) {
+	t.Parallel()
+
+	prior, err := secretHashes{}.update(map[string]types.String{
+		"password":   types.StringValue("hunter2"),
+		"secret_key": types.StringValue("abc"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hashes, err := prior.update(map[string]types.String{
+		"password":    types.StringValue("hunter2"),
+		"secret_key":  types.StringValue("def"),
+		"private_key": types.StringNull(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(prior["password"], hashes["password"]); diff != "" {
+		t.Errorf("expected the hash of an unchanged secret to be kept (-want +got):\n%s", diff)
+	}
+	if !hashes["secret_key"].matches("def") {
+		t.Error("expected the hash of a changed secret to be replaced")
+	}
+	if _, ok := hashes["private_key"]; ok {
+		t.Error("expected no hash for a null secret")
+	}
+}
+
+func TestSecretHashesChanged(t *testing.T) {
+	t.Parallel()
+
+	hashes, err := secretHashes{}.update(map[string]types.String{
+		"password":   types.StringValue("hunter2"),
+		"secret_key": types.StringValue("abc"),
+		"token":      types.StringValue("xyz"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changed := hashes.changed(map[string]types.String{
+		"password":    types.StringValue("hunter2"),
+		"secret_key":  types.StringValue("def"),
+		"token":       types.StringNull(),
+		"private_key": types.StringValue("new"),
+	})
+	if diff := cmp.Diff([]string{"secret_key", "token"}, changed); diff != "" {
+		t.Errorf("unexpected changed secrets (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentryplatforms"
+)
+
+var _ validator.String = rfc3339Validator{}
+var _ validator.String = globValidator{}
+var _ validator.String = platformValidator{}
+var _ validator.String = dataScrubbingSelectorValidator{}
+var _ validator.String = ipOrCidrValidator{}
+
+// rfc3339Validator validates that a string is an RFC 3339 timestamp, e.g.
+// `2024-01-02T15:04:05Z`.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Value %q is not an RFC 3339 timestamp: %s", req.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
+
+// globValidator validates that a string is a well-formed glob pattern, e.g.
+// `backend-*`.
+type globValidator struct{}
+
+func (v globValidator) Description(ctx context.Context) string {
+	return "value must be a valid glob pattern"
+}
+
+func (v globValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v globValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := path.Match(req.ConfigValue.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Glob Pattern",
+			fmt.Sprintf("Value %q is not a valid glob pattern: %s", req.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
+
+// platformValidator validates that a string is a platform known to Sentry, or
+// `other`.
+type platformValidator struct{}
+
+func (v platformValidator) Description(ctx context.Context) string {
+	return "value must be a valid platform"
+}
+
+func (v platformValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v platformValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !sentryplatforms.Validate(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Platform",
+			fmt.Sprintf("%s is not a valid platform", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// dataScrubbingSelectorValidator validates the syntax of a data scrubbing
+// selector, e.g. `$http.headers.x-custom-token`.
+type dataScrubbingSelectorValidator struct{}
+
+func (v dataScrubbingSelectorValidator) Description(ctx context.Context) string {
+	return "value must be a valid data scrubbing selector"
+}
+
+func (v dataScrubbingSelectorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dataScrubbingSelectorValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseDataScrubbingSelector(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Selector",
+			fmt.Sprintf("Value %q is not a valid data scrubbing selector: %s", req.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
+
+// ipOrCidrValidator validates that a string is an IP address, e.g. `127.0.0.1`,
+// or a CIDR range, e.g. `10.0.0.0/8`.
+type ipOrCidrValidator struct{}
+
+func (v ipOrCidrValidator) Description(ctx context.Context) string {
+	return "value must be an IP address or a CIDR range"
+}
+
+func (v ipOrCidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipOrCidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) != nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP Address",
+		fmt.Sprintf("Value %q is not an IP address or a CIDR range.", value),
+	)
+}
@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentrytypes"
+)
+
+// Conversion of issue alerts and metric alerts to the workflow engine model.
+// Used when moving `sentry_issue_alert` to `sentry_workflow` and
+// `sentry_metric_alert` to `sentry_detector`.
+
+// isSentryProviderAddress reports whether a resource is moved from this
+// provider, whichever registry namespace it is installed from.
+func isSentryProviderAddress(address string) bool {
+	return strings.HasSuffix(address, "/sentry")
+}
+
+// issueAlertLogicTypes maps `action_match` and `filter_match` to the logic
+// type of a data condition group.
+var issueAlertLogicTypes = map[string]string{
+	"all":  "all",
+	"any":  "any-short",
+	"none": "none",
+}
+
+// issueAlertConditionTypes maps issue alert condition and filter IDs to data
+// condition types. Frequency conditions depend on their comparison type and
+// are handled separately.
+var issueAlertConditionTypes = map[string]string{
+	"sentry.rules.conditions.first_seen_event.FirstSeenEventCondition":               "first_seen_event",
+	"sentry.rules.conditions.regression_event.RegressionEventCondition":              "regression_event",
+	"sentry.rules.conditions.reappeared_event.ReappearedEventCondition":              "reappeared_event",
+	"sentry.rules.conditions.high_priority_issue.NewHighPriorityIssueCondition":      "new_high_priority_issue",
+	"sentry.rules.conditions.high_priority_issue.ExistingHighPriorityIssueCondition": "existing_high_priority_issue",
+	"sentry.rules.filters.age_comparison.AgeComparisonFilter":                        "age_comparison",
+	"sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter":                  "issue_occurrences",
+	"sentry.rules.filters.assigned_to.AssignedToFilter":                              "assigned_to",
+	"sentry.rules.filters.latest_release.LatestReleaseFilter":                        "latest_release",
+	"sentry.rules.filters.issue_category.IssueCategoryFilter":                        "issue_category",
+	"sentry.rules.filters.event_attribute.EventAttributeFilter":                      "event_attribute",
+	"sentry.rules.filters.tagged_event.TaggedEventFilter":                            "tagged_event",
+	"sentry.rules.filters.level.LevelFilter":                                         "level",
+}
+
+var issueAlertFrequencyConditionTypes = map[string]string{
+	"sentry.rules.conditions.event_frequency.EventFrequencyCondition":           "event_frequency",
+	"sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition": "event_unique_user_frequency",
+	"sentry.rules.conditions.event_frequency.EventFrequencyPercentCondition":    "percent_sessions",
+}
+
+type issueAlertActionConversion struct {
+	Type                string
+	IntegrationKey      string
+	TargetIdentifierKey string
+	TargetDisplayKey    string
+	// DataKeys maps issue alert action fields to workflow action data fields.
+	DataKeys map[string]string
+}
+
+var issueAlertActionConversions = map[string]issueAlertActionConversion{
+	"sentry.integrations.slack.notify_action.SlackNotifyServiceAction": {
+		Type:                "slack",
+		IntegrationKey:      "workspace",
+		TargetIdentifierKey: "channel_id",
+		TargetDisplayKey:    "channel",
+		DataKeys:            map[string]string{"tags": "tags", "notes": "notes"},
+	},
+	"sentry.integrations.msteams.notify_action.MsTeamsNotifyServiceAction": {
+		Type:                "msteams",
+		IntegrationKey:      "team",
+		TargetIdentifierKey: "channel_id",
+		TargetDisplayKey:    "channel",
+	},
+	"sentry.integrations.discord.notify_action.DiscordNotifyServiceAction": {
+		Type:                "discord",
+		IntegrationKey:      "server",
+		TargetIdentifierKey: "channel_id",
+		DataKeys:            map[string]string{"tags": "tags"},
+	},
+	"sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction": {
+		Type:                "pagerduty",
+		IntegrationKey:      "account",
+		TargetIdentifierKey: "service",
+		DataKeys:            map[string]string{"severity": "priority"},
+	},
+	"sentry.integrations.opsgenie.notify_action.OpsgenieNotifyTeamAction": {
+		Type:                "opsgenie",
+		IntegrationKey:      "account",
+		TargetIdentifierKey: "team",
+		DataKeys:            map[string]string{"priority": "priority"},
+	},
+	"sentry.rules.actions.notify_event_service.NotifyEventServiceAction": {
+		Type:                "webhook",
+		TargetIdentifierKey: "service",
+	},
+	"sentry.rules.actions.notify_event.NotifyEventAction": {
+		Type: "plugin",
+	},
+}
+
+const issueAlertEmailActionId = "sentry.mail.actions.NotifyEmailAction"
+
+var issueAlertEmailTargetTypes = map[string]string{
+	"IssueOwners": "issue_owners",
+	"Team":        "team",
+	"Member":      "user",
+}
+
+// decodeLegacyRuleItems decodes the JSON list of issue alert conditions,
+// filters, or actions. Numbers are kept as is so that IDs are not mangled.
+func decodeLegacyRuleItems(value sentrytypes.LossyJson) ([]map[string]interface{}, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var items []map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value.ValueString())))
+	decoder.UseNumber()
+	if err := decoder.Decode(&items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func legacyRuleItemId(item map[string]interface{}) string {
+	id, _ := item["id"].(string)
+	return id
+}
+
+func legacyRuleItemString(item map[string]interface{}, key string) (string, bool) {
+	switch v := item[key].(type) {
+	case string:
+		return v, v != ""
+	case json.Number:
+		return v.String(), true
+	default:
+		return "", false
+	}
+}
+
+func jsonValue(v interface{}) sentrytypes.LossyJson {
+	return sentrytypes.NewLossyJsonValue(string(must.Get(json.Marshal(v))))
+}
+
+func convertIssueAlertCondition(item map[string]interface{}) (DataConditionModel, error) {
+	id := legacyRuleItemId(item)
+
+	conditionType, ok := issueAlertConditionTypes[id]
+	if !ok {
+		frequencyType, ok := issueAlertFrequencyConditionTypes[id]
+		if !ok {
+			return DataConditionModel{}, fmt.Errorf("unsupported issue alert condition or filter %q", id)
+		}
+
+		comparisonType, _ := item["comparisonType"].(string)
+		if comparisonType == "percent" {
+			conditionType = frequencyType + "_percent"
+		} else {
+			conditionType = frequencyType + "_count"
+		}
+	}
+
+	comparison := map[string]interface{}{}
+	for key, value := range item {
+		if key != "id" && key != "name" && key != "comparisonType" {
+			comparison[key] = value
+		}
+	}
+
+	model := DataConditionModel{
+		Type:            types.StringValue(conditionType),
+		Comparison:      jsonValue(true),
+		ConditionResult: jsonValue(true),
+	}
+	if len(comparison) > 0 {
+		model.Comparison = jsonValue(comparison)
+	}
+	return model, nil
+}
+
+func convertIssueAlertAction(item map[string]interface{}) (WorkflowActionModel, error) {
+	id := legacyRuleItemId(item)
+	data := map[string]interface{}{}
+	config := map[string]interface{}{}
+
+	model := WorkflowActionModel{
+		IntegrationId: types.StringNull(),
+	}
+
+	if id == issueAlertEmailActionId {
+		model.Type = types.StringValue("email")
+
+		targetType, _ := item["targetType"].(string)
+		config["targetType"] = issueAlertEmailTargetTypes[targetType]
+		if config["targetType"] == "" {
+			return model, fmt.Errorf("unsupported email action target type %q", targetType)
+		}
+		if targetIdentifier, ok := legacyRuleItemString(item, "targetIdentifier"); ok {
+			config["targetIdentifier"] = targetIdentifier
+		}
+		if fallthroughType, ok := item["fallthroughType"]; ok {
+			data["fallthroughType"] = fallthroughType
+		}
+	} else {
+		conversion, ok := issueAlertActionConversions[id]
+		if !ok {
+			return model, fmt.Errorf("unsupported issue alert action %q", id)
+		}
+		model.Type = types.StringValue(conversion.Type)
+
+		if conversion.IntegrationKey != "" {
+			if integrationId, ok := legacyRuleItemString(item, conversion.IntegrationKey); ok {
+				model.IntegrationId = types.StringValue(integrationId)
+			}
+		}
+		if conversion.TargetIdentifierKey != "" {
+			config["targetType"] = "specific"
+			if targetIdentifier, ok := legacyRuleItemString(item, conversion.TargetIdentifierKey); ok {
+				config["targetIdentifier"] = targetIdentifier
+			}
+		}
+		if conversion.TargetDisplayKey != "" {
+			if targetDisplay, ok := legacyRuleItemString(item, conversion.TargetDisplayKey); ok {
+				config["targetDisplay"] = targetDisplay
+			}
+		}
+		for legacyKey, dataKey := range conversion.DataKeys {
+			if value, ok := item[legacyKey]; ok {
+				data[dataKey] = value
+			}
+		}
+	}
+
+	model.Data = jsonValue(data)
+	model.Config = jsonValue(config)
+	return model, nil
+}
+
+// issueAlertToWorkflow converts the state of an issue alert to the state of
+// the equivalent workflow:
+//   - `conditions` become the triggers, combined with `action_match`.
+//   - `filters` and `actions` become a single action filter, combined with
+//     `filter_match`.
+//   - The remaining fields of each condition and filter become its comparison.
+//
+// The ID is not converted, as workflows have their own IDs.
+func issueAlertToWorkflow(alert IssueAlertResourceModel) (WorkflowResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := WorkflowResourceModel{
+		Id:           types.StringNull(),
+		Organization: alert.Organization,
+		Name:         alert.Name,
+		Enabled:      types.BoolValue(true),
+		Environment:  alert.Environment,
+		Frequency:    alert.Frequency,
+		DetectorIds:  types.SetNull(types.StringType),
+	}
+
+	triggerLogicType, ok := issueAlertLogicTypes[alert.ActionMatch.ValueString()]
+	if !ok {
+		diags.AddError("Conversion Error", fmt.Sprintf("Unsupported action_match %q", alert.ActionMatch.ValueString()))
+		return data, diags
+	}
+	data.Triggers = &DataConditionGroupModel{
+		LogicType: types.StringValue(triggerLogicType),
+	}
+
+	filterLogicType := "all"
+	if !alert.FilterMatch.IsNull() {
+		filterLogicType, ok = issueAlertLogicTypes[alert.FilterMatch.ValueString()]
+		if !ok {
+			diags.AddError("Conversion Error", fmt.Sprintf("Unsupported filter_match %q", alert.FilterMatch.ValueString()))
+			return data, diags
+		}
+	}
+	actionFilter := WorkflowActionFilterModel{
+		LogicType: types.StringValue(filterLogicType),
+	}
+
+	conditions, err := decodeLegacyRuleItems(alert.Conditions)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Error decoding conditions: %s", err.Error()))
+		return data, diags
+	}
+	for _, item := range conditions {
+		condition, err := convertIssueAlertCondition(item)
+		if err != nil {
+			diags.AddError("Conversion Error", err.Error())
+			continue
+		}
+		data.Triggers.Conditions = append(data.Triggers.Conditions, condition)
+	}
+
+	filters, err := decodeLegacyRuleItems(alert.Filters)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Error decoding filters: %s", err.Error()))
+		return data, diags
+	}
+	for _, item := range filters {
+		condition, err := convertIssueAlertCondition(item)
+		if err != nil {
+			diags.AddError("Conversion Error", err.Error())
+			continue
+		}
+		actionFilter.Conditions = append(actionFilter.Conditions, condition)
+	}
+
+	actions, err := decodeLegacyRuleItems(alert.Actions)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("Error decoding actions: %s", err.Error()))
+		return data, diags
+	}
+	for _, item := range actions {
+		action, err := convertIssueAlertAction(item)
+		if err != nil {
+			diags.AddError("Conversion Error", err.Error())
+			continue
+		}
+		actionFilter.Actions = append(actionFilter.Actions, action)
+	}
+
+	data.ActionFilters = []WorkflowActionFilterModel{actionFilter}
+
+	return data, diags
+}
+
+// metricAlertState is the state of the plugin SDK `sentry_metric_alert`
+// resource.
+type metricAlertState struct {
+	Id               string                    `json:"id"`
+	Organization     string                    `json:"organization"`
+	Project          string                    `json:"project"`
+	Name             string                    `json:"name"`
+	Environment      string                    `json:"environment"`
+	Dataset          string                    `json:"dataset"`
+	EventTypes       []string                  `json:"event_types"`
+	Query            string                    `json:"query"`
+	Aggregate        string                    `json:"aggregate"`
+	TimeWindow       float64                   `json:"time_window"`
+	ThresholdType    int64                     `json:"threshold_type"`
+	ResolveThreshold *float64                  `json:"resolve_threshold"`
+	ComparisonDelta  *float64                  `json:"comparison_delta"`
+	Owner            string                    `json:"owner"`
+	InternalId       string                    `json:"internal_id"`
+	Triggers         []metricAlertTriggerState `json:"trigger"`
+}
+
+type metricAlertTriggerState struct {
+	Label          string  `json:"label"`
+	AlertThreshold float64 `json:"alert_threshold"`
+}
+
+// Detector priority levels used as the condition results of metric detectors.
+const (
+	detectorPriorityOk     = 0
+	detectorPriorityMedium = 50
+	detectorPriorityHigh   = 75
+)
+
+var metricAlertTriggerPriorities = map[string]int{
+	"critical": detectorPriorityHigh,
+	"warning":  detectorPriorityMedium,
+}
+
+func formatThreshold(threshold float64) sentrytypes.LossyJson {
+	return sentrytypes.NewLossyJsonValue(strconv.FormatFloat(threshold, 'f', -1, 64))
+}
+
+// metricAlertToDetector converts the state of a metric alert to the state of
+// the equivalent detector:
+//   - Each trigger becomes a `gt` condition (`lt` when the threshold type is
+//     below) with the priority of its label as the result.
+//   - The resolve threshold becomes a condition resolving the issue. Without
+//     one, the issue resolves once the lowest trigger no longer fires.
+//   - The time window and comparison delta are converted from minutes to
+//     seconds.
+//
+// Trigger actions are not converted. Sentry moves them to a separate workflow.
+func metricAlertToDetector(alert metricAlertState) (DetectorResourceModel, error) {
+	data := DetectorResourceModel{
+		Id:              types.StringNull(),
+		Organization:    types.StringValue(alert.Organization),
+		Project:         types.StringValue(alert.Project),
+		Name:            types.StringValue(alert.Name),
+		Enabled:         types.BoolValue(true),
+		Owner:           types.StringNull(),
+		DetectionType:   types.StringValue("static"),
+		ComparisonDelta: types.Int64Null(),
+	}
+	if alert.Owner != "" {
+		data.Owner = types.StringValue(alert.Owner)
+	}
+	if alert.ComparisonDelta != nil {
+		data.DetectionType = types.StringValue("percent")
+		data.ComparisonDelta = types.Int64Value(int64(*alert.ComparisonDelta * 60))
+	}
+
+	data.DataSource = &DetectorDataSourceModel{
+		Query:       types.StringValue(alert.Query),
+		Aggregate:   types.StringValue(alert.Aggregate),
+		TimeWindow:  types.Int64Value(int64(alert.TimeWindow * 60)),
+		Environment: types.StringNull(),
+		Dataset:     types.StringValue(alert.Dataset),
+		EventTypes:  types.SetNull(types.StringType),
+	}
+	if len(alert.EventTypes) > 0 {
+		eventTypeElements := []attr.Value{}
+		for _, eventType := range alert.EventTypes {
+			eventTypeElements = append(eventTypeElements, types.StringValue(eventType))
+		}
+		data.DataSource.EventTypes = types.SetValueMust(types.StringType, eventTypeElements)
+	}
+	if alert.Environment != "" {
+		data.DataSource.Environment = types.StringValue(alert.Environment)
+	}
+
+	triggerType, resolveType := "gt", "lte"
+	if alert.ThresholdType == 1 {
+		triggerType, resolveType = "lt", "gte"
+	}
+
+	data.ConditionGroup = &DataConditionGroupModel{
+		LogicType: types.StringValue("any"),
+	}
+
+	var lowestThreshold *float64
+	for _, trigger := range alert.Triggers {
+		priority, ok := metricAlertTriggerPriorities[trigger.Label]
+		if !ok {
+			return data, fmt.Errorf("unsupported metric alert trigger label %q", trigger.Label)
+		}
+
+		data.ConditionGroup.Conditions = append(data.ConditionGroup.Conditions, DataConditionModel{
+			Type:            types.StringValue(triggerType),
+			Comparison:      formatThreshold(trigger.AlertThreshold),
+			ConditionResult: jsonValue(priority),
+		})
+
+		threshold := trigger.AlertThreshold
+		if lowestThreshold == nil ||
+			(alert.ThresholdType == 0 && threshold < *lowestThreshold) ||
+			(alert.ThresholdType == 1 && threshold > *lowestThreshold) {
+			lowestThreshold = &threshold
+		}
+	}
+
+	resolveThreshold := alert.ResolveThreshold
+	if resolveThreshold == nil {
+		resolveThreshold = lowestThreshold
+	}
+	if resolveThreshold != nil {
+		data.ConditionGroup.Conditions = append(data.ConditionGroup.Conditions, DataConditionModel{
+			Type:            types.StringValue(resolveType),
+			Comparison:      formatThreshold(*resolveThreshold),
+			ConditionResult: jsonValue(detectorPriorityOk),
+		})
+	}
+
+	return data, nil
+}
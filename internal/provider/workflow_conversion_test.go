@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/sentrytypes"
+)
+
+func dataConditionsToStrings(conditions []DataConditionModel) []string {
+	var out []string
+	for _, condition := range conditions {
+		out = append(out, condition.Type.ValueString()+" "+condition.Comparison.ValueString()+" "+condition.ConditionResult.ValueString())
+	}
+	return out
+}
+
+func workflowActionsToStrings(actions []WorkflowActionModel) []string {
+	var out []string
+	for _, action := range actions {
+		out = append(out, action.Type.ValueString()+" "+action.IntegrationId.String()+" "+action.Data.ValueString()+" "+action.Config.ValueString())
+	}
+	return out
+}
+
+func TestIssueAlertToWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		alert              IssueAlertResourceModel
+		expectedTriggers   []string
+		expectedLogicTypes [2]string
+		expectedConditions []string
+		expectedActions    []string
+		expectedError      bool
+	}{
+		"conditions, filters, and actions": {
+			alert: IssueAlertResourceModel{
+				Name:        types.StringValue("My alert"),
+				ActionMatch: types.StringValue("any"),
+				FilterMatch: types.StringValue("all"),
+				Frequency:   types.Int64Value(30),
+				Environment: types.StringValue("production"),
+				Conditions: sentrytypes.NewLossyJsonValue(`[
+					{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition", "name": "A new issue is created"},
+					{"id": "sentry.rules.conditions.event_frequency.EventFrequencyCondition", "comparisonType": "count", "interval": "1h", "value": 100},
+					{"id": "sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition", "comparisonType": "percent", "comparisonInterval": "1w", "interval": "1h", "value": 50}
+				]`),
+				Filters: sentrytypes.NewLossyJsonValue(`[
+					{"id": "sentry.rules.filters.level.LevelFilter", "match": "gte", "level": "40"}
+				]`),
+				Actions: sentrytypes.NewLossyJsonValue(`[
+					{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "Team", "targetIdentifier": 1234, "fallthroughType": "ActiveMembers"},
+					{"id": "sentry.integrations.slack.notify_action.SlackNotifyServiceAction", "workspace": 5678, "channel": "#alerts", "channel_id": "C0123", "tags": "environment"}
+				]`),
+			},
+			expectedLogicTypes: [2]string{"any-short", "all"},
+			expectedTriggers: []string{
+				`first_seen_event true true`,
+				`event_frequency_count {"interval":"1h","value":100} true`,
+				`event_unique_user_frequency_percent {"comparisonInterval":"1w","interval":"1h","value":50} true`,
+			},
+			expectedConditions: []string{
+				`level {"level":"40","match":"gte"} true`,
+			},
+			expectedActions: []string{
+				`email <null> {"fallthroughType":"ActiveMembers"} {"targetIdentifier":"1234","targetType":"team"}`,
+				`slack "5678" {"tags":"environment"} {"targetDisplay":"#alerts","targetIdentifier":"C0123","targetType":"specific"}`,
+			},
+		},
+		"no filters": {
+			alert: IssueAlertResourceModel{
+				Name:        types.StringValue("My alert"),
+				ActionMatch: types.StringValue("all"),
+				FilterMatch: types.StringNull(),
+				Conditions: sentrytypes.NewLossyJsonValue(`[
+					{"id": "sentry.rules.conditions.regression_event.RegressionEventCondition"}
+				]`),
+				Filters: sentrytypes.NewLossyJsonNull(),
+				Actions: sentrytypes.NewLossyJsonValue(`[
+					{"id": "sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction", "account": 11, "service": 22, "severity": "critical"}
+				]`),
+			},
+			expectedLogicTypes: [2]string{"all", "all"},
+			expectedTriggers: []string{
+				`regression_event true true`,
+			},
+			expectedActions: []string{
+				`pagerduty "11" {"priority":"critical"} {"targetIdentifier":"22","targetType":"specific"}`,
+			},
+		},
+		"unsupported condition": {
+			alert: IssueAlertResourceModel{
+				ActionMatch: types.StringValue("all"),
+				Conditions: sentrytypes.NewLossyJsonValue(`[
+					{"id": "sentry.rules.conditions.unknown.UnknownCondition"}
+				]`),
+			},
+			expectedError: true,
+		},
+		"unsupported action": {
+			alert: IssueAlertResourceModel{
+				ActionMatch: types.StringValue("all"),
+				Actions: sentrytypes.NewLossyJsonValue(`[
+					{"id": "sentry.integrations.jira.notify_action.JiraCreateTicketAction"}
+				]`),
+			},
+			expectedError: true,
+		},
+		"unsupported action match": {
+			alert: IssueAlertResourceModel{
+				ActionMatch: types.StringValue("some"),
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, diags := issueAlertToWorkflow(tc.alert)
+			if tc.expectedError {
+				if !diags.HasError() {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !data.Name.Equal(tc.alert.Name) || !data.Environment.Equal(tc.alert.Environment) || !data.Frequency.Equal(tc.alert.Frequency) {
+				t.Errorf("unexpected workflow fields: %v, %v, %v", data.Name, data.Environment, data.Frequency)
+			}
+			if len(data.ActionFilters) != 1 {
+				t.Fatalf("expected 1 action filter, got %d", len(data.ActionFilters))
+			}
+
+			logicTypes := [2]string{data.Triggers.LogicType.ValueString(), data.ActionFilters[0].LogicType.ValueString()}
+			if diff := cmp.Diff(tc.expectedLogicTypes, logicTypes); diff != "" {
+				t.Errorf("unexpected logic types (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedTriggers, dataConditionsToStrings(data.Triggers.Conditions)); diff != "" {
+				t.Errorf("unexpected triggers (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedConditions, dataConditionsToStrings(data.ActionFilters[0].Conditions)); diff != "" {
+				t.Errorf("unexpected action filter conditions (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedActions, workflowActionsToStrings(data.ActionFilters[0].Actions)); diff != "" {
+				t.Errorf("unexpected actions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricAlertToDetector(t *testing.T) {
+	t.Parallel()
+
+	resolveThreshold := 80.0
+	comparisonDelta := 10080.0
+
+	testCases := map[string]struct {
+		alert                   metricAlertState
+		expectedDetectionType   string
+		expectedComparisonDelta types.Int64
+		expectedConditions      []string
+		expectedError           bool
+	}{
+		"above thresholds": {
+			alert: metricAlertState{
+				TimeWindow:    60,
+				ThresholdType: 0,
+				Triggers: []metricAlertTriggerState{
+					{Label: "critical", AlertThreshold: 300},
+					{Label: "warning", AlertThreshold: 100.5},
+				},
+			},
+			expectedDetectionType:   "static",
+			expectedComparisonDelta: types.Int64Null(),
+			expectedConditions: []string{
+				"gt 300 75",
+				"gt 100.5 50",
+				"lte 100.5 0",
+			},
+		},
+		"below thresholds with resolve threshold": {
+			alert: metricAlertState{
+				TimeWindow:       60,
+				ThresholdType:    1,
+				ResolveThreshold: &resolveThreshold,
+				Triggers: []metricAlertTriggerState{
+					{Label: "critical", AlertThreshold: 50},
+					{Label: "warning", AlertThreshold: 70},
+				},
+			},
+			expectedDetectionType:   "static",
+			expectedComparisonDelta: types.Int64Null(),
+			expectedConditions: []string{
+				"lt 50 75",
+				"lt 70 50",
+				"gte 80 0",
+			},
+		},
+		"percent change": {
+			alert: metricAlertState{
+				TimeWindow:      60,
+				ComparisonDelta: &comparisonDelta,
+				Triggers: []metricAlertTriggerState{
+					{Label: "critical", AlertThreshold: 20},
+				},
+			},
+			expectedDetectionType:   "percent",
+			expectedComparisonDelta: types.Int64Value(604800),
+			expectedConditions: []string{
+				"gt 20 75",
+				"lte 20 0",
+			},
+		},
+		"unsupported trigger label": {
+			alert: metricAlertState{
+				Triggers: []metricAlertTriggerState{
+					{Label: "info", AlertThreshold: 20},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := metricAlertToDetector(tc.alert)
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if data.DetectionType.ValueString() != tc.expectedDetectionType {
+				t.Errorf("expected detection type %q, got %q", tc.expectedDetectionType, data.DetectionType.ValueString())
+			}
+			if !data.ComparisonDelta.Equal(tc.expectedComparisonDelta) {
+				t.Errorf("expected comparison delta %v, got %v", tc.expectedComparisonDelta, data.ComparisonDelta)
+			}
+			if data.DataSource.TimeWindow.ValueInt64() != int64(tc.alert.TimeWindow*60) {
+				t.Errorf("expected time window %v seconds, got %v", tc.alert.TimeWindow*60, data.DataSource.TimeWindow)
+			}
+			if diff := cmp.Diff(tc.expectedConditions, dataConditionsToStrings(data.ConditionGroup.Conditions)); diff != "" {
+				t.Errorf("unexpected conditions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
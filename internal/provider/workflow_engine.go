@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/canva/terraform-provider-sentry/internal/pkg/must"
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
+	"github.com/canva/terraform-provider-sentry/internal/sentrytypes"
+)
+
+// Shared models and schemas of the workflow engine resources, i.e. detectors
+// and workflows.
+
+// privateKeyMovedAlertId holds the ID of the issue or metric alert a resource
+// was moved from, until the ID of its replacement has been looked up.
+const privateKeyMovedAlertId = "moved_alert_id"
+
+// privateStateGetter is implemented by the private state of read requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func movedAlertIdFromPrivate(ctx context.Context, private privateStateGetter) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateKeyMovedAlertId)
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
+	}
+
+	var alertId string
+	if err := json.Unmarshal(value, &alertId); err != nil {
+		diags.AddError("Private State Error", fmt.Sprintf("Error reading moved alert ID: %s", err.Error()))
+	}
+	return alertId, diags
+}
+
+var dataConditionGroupLogicTypes = []string{"any", "any-short", "all", "none"}
+
+type DataConditionModel struct {
+	Type            types.String          `tfsdk:"type"`
+	Comparison      sentrytypes.LossyJson `tfsdk:"comparison"`
+	ConditionResult sentrytypes.LossyJson `tfsdk:"condition_result"`
+}
+
+type DataConditionGroupModel struct {
+	LogicType  types.String         `tfsdk:"logic_type"`
+	Conditions []DataConditionModel `tfsdk:"conditions"`
+}
+
+func (m DataConditionModel) ToAPI() (sentryclient.DataCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	condition := sentryclient.DataCondition{
+		Type: m.Type.ValueString(),
+	}
+
+	var comparison, conditionResult interface{}
+	diags.Append(m.Comparison.Unmarshal(&comparison)...)
+	diags.Append(m.ConditionResult.Unmarshal(&conditionResult)...)
+	if diags.HasError() {
+		return condition, diags
+	}
+
+	condition.Comparison = must.Get(json.Marshal(comparison))
+	condition.ConditionResult = must.Get(json.Marshal(conditionResult))
+	return condition, diags
+}
+
+func (m *DataConditionModel) Fill(condition sentryclient.DataCondition) error {
+	m.Type = types.StringValue(condition.Type)
+	m.Comparison = sentrytypes.NewLossyJsonValue(string(condition.Comparison))
+	m.ConditionResult = sentrytypes.NewLossyJsonValue(string(condition.ConditionResult))
+	return nil
+}
+
+func (m DataConditionGroupModel) ToAPI() (*sentryclient.DataConditionGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	group := &sentryclient.DataConditionGroup{
+		LogicType:  m.LogicType.ValueString(),
+		Conditions: []sentryclient.DataCondition{},
+	}
+
+	for _, conditionModel := range m.Conditions {
+		condition, conditionDiags := conditionModel.ToAPI()
+		diags.Append(conditionDiags...)
+		group.Conditions = append(group.Conditions, condition)
+	}
+
+	return group, diags
+}
+
+func (m *DataConditionGroupModel) Fill(group sentryclient.DataConditionGroup) error {
+	m.LogicType = types.StringValue(group.LogicType)
+
+	m.Conditions = nil
+	for _, condition := range group.Conditions {
+		var conditionModel DataConditionModel
+		if err := conditionModel.Fill(condition); err != nil {
+			return err
+		}
+		m.Conditions = append(m.Conditions, conditionModel)
+	}
+
+	return nil
+}
+
+func dataConditionGroupAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"logic_type": schema.StringAttribute{
+			MarkdownDescription: "How the conditions are combined. One of `any`, `any-short`, `all`, or `none`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(dataConditionGroupLogicTypes...),
+			},
+		},
+		"conditions": schema.ListNestedAttribute{
+			MarkdownDescription: "The conditions of the group.",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the condition, e.g. `gt` or `first_seen_event`.",
+						Required:            true,
+					},
+					"comparison": schema.StringAttribute{
+						MarkdownDescription: "The value the condition compares against. In JSON string format.",
+						Required:            true,
+						CustomType:          sentrytypes.LossyJsonType{},
+					},
+					"condition_result": schema.StringAttribute{
+						MarkdownDescription: "The result when the condition passes, e.g. `true` or a detector priority level. In JSON string format.",
+						Required:            true,
+						CustomType:          sentrytypes.LossyJsonType{},
+					},
+				},
+			},
+		},
+	}
+}
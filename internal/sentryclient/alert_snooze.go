@@ -0,0 +1,85 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+const (
+	// AlertRuleTypeIssue is the URL segment for issue alert rules.
+	AlertRuleTypeIssue = "rules"
+	// AlertRuleTypeMetric is the URL segment for metric alert rules.
+	AlertRuleTypeMetric = "alert-rules"
+)
+
+const (
+	AlertSnoozeTargetEveryone = "everyone"
+	AlertSnoozeTargetMe       = "me"
+)
+
+type AlertSnooze struct {
+	OwnerID   *int       `json:"ownerId,omitempty"`
+	UserID    *int       `json:"userId,omitempty"`
+	Until     *time.Time `json:"until,omitempty"`
+	DateAdded *time.Time `json:"dateAdded,omitempty"`
+}
+
+type AlertSnoozeParams struct {
+	Target string     `json:"target"`
+	Until  *time.Time `json:"until,omitempty"`
+}
+
+// AlertSnoozeStatus holds the snooze fields returned alongside an alert rule.
+// Sentry only reports snoozes that are still active.
+type AlertSnoozeStatus struct {
+	Snooze            bool `json:"snooze"`
+	SnoozeForEveryone bool `json:"snoozeForEveryone"`
+}
+
+// GetAlertSnoozeStatus returns whether an issue or metric alert rule is
+// currently snoozed.
+func GetAlertSnoozeStatus(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, ruleType string, ruleID string) (*AlertSnoozeStatus, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/%v/%v/", organizationSlug, projectSlug, ruleType, ruleID)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(AlertSnoozeStatus)
+	resp, err := client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+	return status, resp, nil
+}
+
+// SnoozeAlert mutes an issue or metric alert rule, either for everyone or for
+// the user owning the auth token.
+func SnoozeAlert(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, ruleType string, ruleID string, params *AlertSnoozeParams) (*AlertSnooze, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/%v/%v/snooze/", organizationSlug, projectSlug, ruleType, ruleID)
+	req, err := client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snooze := new(AlertSnooze)
+	resp, err := client.Do(ctx, req, snooze)
+	if err != nil {
+		return nil, resp, err
+	}
+	return snooze, resp, nil
+}
+
+// UnsnoozeAlert removes the snooze from an issue or metric alert rule.
+func UnsnoozeAlert(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, ruleType string, ruleID string, target string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/%v/%v/snooze/", organizationSlug, projectSlug, ruleType, ruleID)
+	req, err := client.NewRequest("DELETE", u, &AlertSnoozeParams{Target: target})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
@@ -0,0 +1,92 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// AlertNamePrefix marks the last part of an alert import ID as a rule name
+// rather than a rule ID, e.g. `organization/project/name:My rule`.
+const AlertNamePrefix = "name:"
+
+// FindIssueAlertByName returns the only issue alert in the project with the
+// given name.
+func FindIssueAlertByName(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, name string) (*sentry.IssueAlert, error) {
+	var matches []*sentry.IssueAlert
+	params := &sentry.ListCursorParams{}
+
+	for {
+		alerts, resp, err := client.IssueAlerts.List(ctx, organizationSlug, projectSlug, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, alert := range alerts {
+			if sentry.StringValue(alert.Name) == name {
+				matches = append(matches, alert)
+			}
+		}
+
+		if resp.Cursor == "" {
+			break
+		}
+		params.Cursor = resp.Cursor
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, alert := range matches {
+		ids = append(ids, sentry.StringValue(alert.ID))
+	}
+	if err := checkAlertNameMatches("issue alert", projectSlug, name, ids); err != nil {
+		return nil, err
+	}
+	return matches[0], nil
+}
+
+// FindMetricAlertByName returns the only metric alert in the project with the
+// given name.
+func FindMetricAlertByName(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, name string) (*sentry.MetricAlert, error) {
+	var matches []*sentry.MetricAlert
+	params := &sentry.ListCursorParams{}
+
+	for {
+		alerts, resp, err := client.MetricAlerts.List(ctx, organizationSlug, projectSlug, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, alert := range alerts {
+			if sentry.StringValue(alert.Name) == name {
+				matches = append(matches, alert)
+			}
+		}
+
+		if resp.Cursor == "" {
+			break
+		}
+		params.Cursor = resp.Cursor
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, alert := range matches {
+		ids = append(ids, sentry.StringValue(alert.ID))
+	}
+	if err := checkAlertNameMatches("metric alert", projectSlug, name, ids); err != nil {
+		return nil, err
+	}
+	return matches[0], nil
+}
+
+func checkAlertNameMatches(kind string, projectSlug string, name string, ids []string) error {
+	switch len(ids) {
+	case 0:
+		return fmt.Errorf("no %s named %q found in project %s", kind, name, projectSlug)
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("%d %ss named %q found in project %s (IDs: %s), import by ID instead", len(ids), kind, name, projectSlug, strings.Join(ids, ", "))
+	}
+}
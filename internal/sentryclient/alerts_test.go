@@ -0,0 +1,36 @@
+package sentryclient
+
+import (
+	"testing"
+)
+
+func TestCheckAlertNameMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ids         []string
+		expectedErr string
+	}{
+		"none":     {ids: nil, expectedErr: `no issue alert named "My rule" found in project backend`},
+		"one":      {ids: []string{"1"}},
+		"multiple": {ids: []string{"1", "2"}, expectedErr: `2 issue alerts named "My rule" found in project backend (IDs: 1, 2), import by ID instead`},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkAlertNameMatches("issue alert", "backend", "My rule", tc.ids)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
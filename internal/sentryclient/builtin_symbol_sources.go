@@ -0,0 +1,32 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// BuiltinSymbolSource is a symbol server that Sentry provides, e.g. the
+// Microsoft symbol server. Projects enable them by their key.
+type BuiltinSymbolSource struct {
+	Key    string `json:"sentry_key"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Hidden bool   `json:"hidden"`
+}
+
+func ListBuiltinSymbolSources(ctx context.Context, client *sentry.Client, organizationSlug string) ([]*BuiltinSymbolSource, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/builtin-symbol-sources/", organizationSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sources []*BuiltinSymbolSource
+	resp, err := client.Do(ctx, req, &sources)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sources, resp, nil
+}
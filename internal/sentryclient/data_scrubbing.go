@@ -0,0 +1,52 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// RelayPiiConfig is the advanced data scrubbing configuration of a project or
+// organization, sent to Relay as JSON in the `relayPiiConfig` field.
+type RelayPiiConfig struct {
+	// Rules are keyed by an identifier that is referenced by Applications.
+	Rules map[string]RelayPiiRule `json:"rules"`
+	// Applications map a selector, e.g. `$string`, to the identifiers of the
+	// rules applied to the values it matches.
+	Applications map[string][]string `json:"applications"`
+}
+
+type RelayPiiRule struct {
+	Type      string            `json:"type"`
+	Pattern   *string           `json:"pattern,omitempty"`
+	Redaction RelayPiiRedaction `json:"redaction"`
+}
+
+type RelayPiiRedaction struct {
+	Method string  `json:"method"`
+	Text   *string `json:"text,omitempty"`
+}
+
+type updateOrganizationRelayPiiConfigParams struct {
+	RelayPiiConfig string `json:"relayPiiConfig"`
+}
+
+// UpdateOrganizationRelayPiiConfig sets the advanced data scrubbing rules of an
+// organization. An empty config clears the rules.
+func UpdateOrganizationRelayPiiConfig(ctx context.Context, client *sentry.Client, organizationSlug string, config string) (*sentry.Organization, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/", organizationSlug)
+	req, err := client.NewRequest("PUT", u, &updateOrganizationRelayPiiConfigParams{
+		RelayPiiConfig: config,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	org := new(sentry.Organization)
+	resp, err := client.Do(ctx, req, org)
+	if err != nil {
+		return nil, resp, err
+	}
+	return org, resp, nil
+}
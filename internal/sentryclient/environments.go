@@ -0,0 +1,86 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+type ProjectEnvironment struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	IsHidden bool   `json:"isHidden"`
+}
+
+type UpdateProjectEnvironmentParams struct {
+	IsHidden bool `json:"isHidden"`
+}
+
+// ListProjectEnvironments returns the environments of a project. visibility
+// is one of `all`, `visible` or `hidden`.
+func ListProjectEnvironments(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, visibility string) ([]*ProjectEnvironment, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/environments/?visibility=%v", organizationSlug, projectSlug, url.QueryEscape(visibility))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var environments []*ProjectEnvironment
+	resp, err := client.Do(ctx, req, &environments)
+	if err != nil {
+		return nil, resp, err
+	}
+	return environments, resp, nil
+}
+
+func GetProjectEnvironment(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, name string) (*ProjectEnvironment, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/environments/%v/", organizationSlug, projectSlug, url.PathEscape(name))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(ProjectEnvironment)
+	resp, err := client.Do(ctx, req, environment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return environment, resp, nil
+}
+
+func UpdateProjectEnvironment(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, name string, params *UpdateProjectEnvironmentParams) (*ProjectEnvironment, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/environments/%v/", organizationSlug, projectSlug, url.PathEscape(name))
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(ProjectEnvironment)
+	resp, err := client.Do(ctx, req, environment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return environment, resp, nil
+}
+
+// UnknownEnvironmentWarning returns a warning if the environment has not been
+// seen in the project yet, which usually means it is misspelt. Sentry creates
+// environments when it receives their first event, so this is not an error.
+func UnknownEnvironmentWarning(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, name string) (string, error) {
+	environments, _, err := ListProjectEnvironments(ctx, client, organizationSlug, projectSlug, "all")
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(environments))
+	for _, environment := range environments {
+		if environment.Name == name {
+			return "", nil
+		}
+		names = append(names, environment.Name)
+	}
+
+	return fmt.Sprintf("Environment %q has not been seen in project %s yet. Known environments: %v. Sentry creates an environment when it receives its first event, so this may be expected for a new environment, otherwise check for typos.", name, projectSlug, names), nil
+}
@@ -0,0 +1,125 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// ExternalActor links a user or team of an external provider, e.g. a GitHub
+// username or a Slack channel, to a Sentry user or team.
+type ExternalActor struct {
+	ID            string  `json:"id"`
+	Provider      string  `json:"provider"`
+	ExternalName  string  `json:"externalName"`
+	ExternalID    *string `json:"externalId"`
+	IntegrationID string  `json:"integrationId"`
+	UserID        *string `json:"userId,omitempty"`
+	TeamID        *string `json:"teamId,omitempty"`
+}
+
+type ExternalUserParams struct {
+	UserID        string  `json:"user_id"`
+	Provider      string  `json:"provider"`
+	ExternalName  string  `json:"external_name"`
+	ExternalID    *string `json:"external_id,omitempty"`
+	IntegrationID string  `json:"integration_id"`
+}
+
+type ExternalTeamParams struct {
+	TeamID        string  `json:"team_id"`
+	Provider      string  `json:"provider"`
+	ExternalName  string  `json:"external_name"`
+	ExternalID    *string `json:"external_id,omitempty"`
+	IntegrationID string  `json:"integration_id"`
+}
+
+func doExternalActorRequest(ctx context.Context, client *sentry.Client, method string, u string, params interface{}) (*ExternalActor, *sentry.Response, error) {
+	req, err := client.NewRequest(method, u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actor := new(ExternalActor)
+	resp, err := client.Do(ctx, req, actor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return actor, resp, nil
+}
+
+// ListMemberExternalUsers returns the external users linked to the user of an
+// organization member.
+func ListMemberExternalUsers(ctx context.Context, client *sentry.Client, organizationSlug string, memberID string) ([]ExternalActor, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/members/%v/?expand=externalUsers", organizationSlug, memberID)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var member struct {
+		ExternalUsers []ExternalActor `json:"externalUsers"`
+	}
+	resp, err := client.Do(ctx, req, &member)
+	if err != nil {
+		return nil, resp, err
+	}
+	return member.ExternalUsers, resp, nil
+}
+
+func CreateExternalUser(ctx context.Context, client *sentry.Client, organizationSlug string, params *ExternalUserParams) (*ExternalActor, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/external-users/", organizationSlug)
+	return doExternalActorRequest(ctx, client, "POST", u, params)
+}
+
+func UpdateExternalUser(ctx context.Context, client *sentry.Client, organizationSlug string, id string, params *ExternalUserParams) (*ExternalActor, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/external-users/%v/", organizationSlug, id)
+	return doExternalActorRequest(ctx, client, "PUT", u, params)
+}
+
+func DeleteExternalUser(ctx context.Context, client *sentry.Client, organizationSlug string, id string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/external-users/%v/", organizationSlug, id)
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(ctx, req, nil)
+}
+
+// ListTeamExternalTeams returns the external teams linked to a team.
+func ListTeamExternalTeams(ctx context.Context, client *sentry.Client, organizationSlug string, teamSlug string) ([]ExternalActor, *sentry.Response, error) {
+	u := fmt.Sprintf("0/teams/%v/%v/?expand=externalTeams", organizationSlug, teamSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var team struct {
+		ExternalTeams []ExternalActor `json:"externalTeams"`
+	}
+	resp, err := client.Do(ctx, req, &team)
+	if err != nil {
+		return nil, resp, err
+	}
+	return team.ExternalTeams, resp, nil
+}
+
+func CreateExternalTeam(ctx context.Context, client *sentry.Client, organizationSlug string, teamSlug string, params *ExternalTeamParams) (*ExternalActor, *sentry.Response, error) {
+	u := fmt.Sprintf("0/teams/%v/%v/external-teams/", organizationSlug, teamSlug)
+	return doExternalActorRequest(ctx, client, "POST", u, params)
+}
+
+func UpdateExternalTeam(ctx context.Context, client *sentry.Client, organizationSlug string, teamSlug string, id string, params *ExternalTeamParams) (*ExternalActor, *sentry.Response, error) {
+	u := fmt.Sprintf("0/teams/%v/%v/external-teams/%v/", organizationSlug, teamSlug, id)
+	return doExternalActorRequest(ctx, client, "PUT", u, params)
+}
+
+func DeleteExternalTeam(ctx context.Context, client *sentry.Client, organizationSlug string, teamSlug string, id string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/teams/%v/%v/external-teams/%v/", organizationSlug, teamSlug, id)
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(ctx, req, nil)
+}
@@ -0,0 +1,146 @@
+package sentryclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+const (
+	OwnerTypeTeam = "team"
+	OwnerTypeUser = "user"
+)
+
+// ErrOwnerNotFound is returned when a team slug or member email used as an
+// owner does not exist in the organization.
+var ErrOwnerNotFound = errors.New("owner not found")
+
+type ownerCacheKey struct {
+	baseURL      string
+	organization string
+	owner        string
+}
+
+// ownerCache holds resolved owners for the lifetime of the provider process,
+// which is a single Terraform run.
+var ownerCache sync.Map
+
+// ParseOwner splits an owner of the form `team:<id|slug>` or
+// `user:<id|email>` into its type and reference.
+func ParseOwner(owner string) (string, string, error) {
+	ownerType, ref, ok := strings.Cut(owner, ":")
+	if !ok || ref == "" || (ownerType != OwnerTypeTeam && ownerType != OwnerTypeUser) {
+		return "", "", fmt.Errorf("unexpected format of owner (%s), expected team:<id|slug> or user:<id|email>", owner)
+	}
+	return ownerType, ref, nil
+}
+
+// IsOwnerID reports whether the owner reference is already a Sentry actor ID.
+func IsOwnerID(ref string) bool {
+	if ref == "" {
+		return false
+	}
+	for _, r := range ref {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveOwner converts `team:<slug>` and `user:<email>` into the
+// `team:<id>` and `user:<id>` form accepted by the Sentry API. Owners that
+// already use IDs are returned unchanged.
+func ResolveOwner(ctx context.Context, client *sentry.Client, organizationSlug string, owner string) (string, error) {
+	ownerType, ref, err := ParseOwner(owner)
+	if err != nil {
+		return "", err
+	}
+	if IsOwnerID(ref) {
+		return owner, nil
+	}
+
+	key := ownerCacheKey{
+		baseURL:      client.BaseURL.String(),
+		organization: organizationSlug,
+		owner:        owner,
+	}
+	if v, ok := ownerCache.Load(key); ok {
+		return v.(string), nil
+	}
+
+	var id string
+	switch ownerType {
+	case OwnerTypeTeam:
+		id, err = resolveTeamID(ctx, client, organizationSlug, ref)
+	case OwnerTypeUser:
+		id, err = resolveUserID(ctx, client, organizationSlug, ref)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	resolved := ownerType + ":" + id
+	ownerCache.Store(key, resolved)
+	return resolved, nil
+}
+
+// OwnerEquals reports whether the configured owner refers to the same actor as
+// the owner returned by Sentry.
+func OwnerEquals(ctx context.Context, client *sentry.Client, organizationSlug string, configured string, remote string) (bool, error) {
+	if configured == remote {
+		return true, nil
+	}
+
+	resolved, err := ResolveOwner(ctx, client, organizationSlug, configured)
+	if errors.Is(err, ErrOwnerNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return resolved == remote, nil
+}
+
+func resolveTeamID(ctx context.Context, client *sentry.Client, organizationSlug string, teamSlug string) (string, error) {
+	team, resp, err := client.Teams.Get(ctx, organizationSlug, teamSlug)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("%w: team %q", ErrOwnerNotFound, teamSlug)
+	}
+	if err != nil {
+		return "", err
+	}
+	return sentry.StringValue(team.ID), nil
+}
+
+func resolveUserID(ctx context.Context, client *sentry.Client, organizationSlug string, email string) (string, error) {
+	params := &sentry.ListCursorParams{}
+
+	for {
+		members, resp, err := client.OrganizationMembers.List(ctx, organizationSlug, params)
+		if err != nil {
+			return "", err
+		}
+
+		for _, member := range members {
+			if strings.EqualFold(member.Email, email) {
+				if member.User.ID == "" {
+					return "", fmt.Errorf("member %q has not accepted their invitation yet", email)
+				}
+				return member.User.ID, nil
+			}
+		}
+
+		if resp.Cursor == "" {
+			break
+		}
+		params.Cursor = resp.Cursor
+	}
+
+	return "", fmt.Errorf("%w: member %q", ErrOwnerNotFound, email)
+}
@@ -0,0 +1,67 @@
+package sentryclient
+
+import (
+	"testing"
+)
+
+func TestParseOwner(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		owner        string
+		expectedType string
+		expectedRef  string
+		expectedErr  bool
+	}{
+		"team id":        {owner: "team:123", expectedType: "team", expectedRef: "123"},
+		"team slug":      {owner: "team:payments", expectedType: "team", expectedRef: "payments"},
+		"user id":        {owner: "user:456", expectedType: "user", expectedRef: "456"},
+		"user email":     {owner: "user:jane@example.com", expectedType: "user", expectedRef: "jane@example.com"},
+		"missing type":   {owner: "123", expectedErr: true},
+		"unknown type":   {owner: "org:123", expectedErr: true},
+		"empty ref":      {owner: "team:", expectedErr: true},
+		"empty string":   {owner: "", expectedErr: true},
+		"nested colon":   {owner: "user:a:b", expectedType: "user", expectedRef: "a:b"},
+		"uppercase type": {owner: "TEAM:123", expectedErr: true},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ownerType, ref, err := ParseOwner(tc.owner)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected error, got type=%q ref=%q", ownerType, ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if ownerType != tc.expectedType || ref != tc.expectedRef {
+				t.Errorf("expected %s/%s, got %s/%s", tc.expectedType, tc.expectedRef, ownerType, ref)
+			}
+		})
+	}
+}
+
+func TestIsOwnerID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123":              true,
+		"0":                true,
+		"":                 false,
+		"payments":         false,
+		"12a":              false,
+		"jane@example.com": false,
+	}
+
+	for ref, expected := range testCases {
+		if got := IsOwnerID(ref); got != expected {
+			t.Errorf("IsOwnerID(%q): expected %t, got %t", ref, expected, got)
+		}
+	}
+}
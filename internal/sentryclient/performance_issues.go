@@ -0,0 +1,45 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// ProjectPerformanceIssueSettings holds the settings of the performance issue
+// detectors of a project, keyed by setting name, e.g.
+// `n_plus_one_db_queries_detection_enabled` or `slow_db_query_duration_threshold`.
+type ProjectPerformanceIssueSettings map[string]interface{}
+
+func GetProjectPerformanceIssueSettings(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string) (ProjectPerformanceIssueSettings, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/performance-issues/configure/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := ProjectPerformanceIssueSettings{}
+	resp, err := client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return settings, resp, nil
+}
+
+// UpdateProjectPerformanceIssueSettings changes the given settings and leaves
+// the others as they are.
+func UpdateProjectPerformanceIssueSettings(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, params ProjectPerformanceIssueSettings) (ProjectPerformanceIssueSettings, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/performance-issues/configure/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := ProjectPerformanceIssueSettings{}
+	resp, err := client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return settings, resp, nil
+}
@@ -0,0 +1,43 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// ProjectPlugin is a plugin bound to a project, including whether it is
+// enabled for the project.
+type ProjectPlugin struct {
+	sentry.ProjectPlugin
+	Enabled bool `json:"enabled"`
+}
+
+// ConfigValue returns the value of a config field of the plugin, or the empty
+// string if it is not set. Sentry masks the values of secret fields.
+func (p *ProjectPlugin) ConfigValue(name string) string {
+	for _, config := range p.Config {
+		if config.Name == name {
+			if v, ok := config.Value.(string); ok {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+func GetProjectPlugin(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string) (*ProjectPlugin, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/plugins/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugin := new(ProjectPlugin)
+	resp, err := client.Do(ctx, req, plugin)
+	if err != nil {
+		return nil, resp, err
+	}
+	return plugin, resp, nil
+}
@@ -0,0 +1,87 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// ProjectCodeOwnersErrors lists the owners of a CODEOWNERS file that could not
+// be mapped to Sentry users and teams.
+type ProjectCodeOwnersErrors struct {
+	MissingExternalTeams []string `json:"missing_external_teams"`
+	MissingExternalUsers []string `json:"missing_external_users"`
+	MissingUserEmails    []string `json:"missing_user_emails"`
+	TeamsWithoutAccess   []string `json:"teams_without_access"`
+	UsersWithoutAccess   []string `json:"users_without_access"`
+}
+
+type ProjectCodeOwners struct {
+	ID              string                  `json:"id"`
+	Raw             string                  `json:"raw"`
+	CodeMappingID   string                  `json:"codeMappingId"`
+	Provider        string                  `json:"provider"`
+	OwnershipSyntax string                  `json:"ownershipSyntax"`
+	Errors          ProjectCodeOwnersErrors `json:"errors"`
+}
+
+type ProjectCodeOwnersParams struct {
+	Raw           string `json:"raw"`
+	CodeMappingID string `json:"codeMappingId"`
+}
+
+func ListProjectCodeOwners(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string) ([]*ProjectCodeOwners, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/codeowners/?expand=ownershipSyntax&expand=errors", organizationSlug, projectSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var codeOwners []*ProjectCodeOwners
+	resp, err := client.Do(ctx, req, &codeOwners)
+	if err != nil {
+		return nil, resp, err
+	}
+	return codeOwners, resp, nil
+}
+
+func CreateProjectCodeOwners(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, params *ProjectCodeOwnersParams) (*ProjectCodeOwners, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/codeowners/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeOwners := new(ProjectCodeOwners)
+	resp, err := client.Do(ctx, req, codeOwners)
+	if err != nil {
+		return nil, resp, err
+	}
+	return codeOwners, resp, nil
+}
+
+func UpdateProjectCodeOwners(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string, params *ProjectCodeOwnersParams) (*ProjectCodeOwners, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/codeowners/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeOwners := new(ProjectCodeOwners)
+	resp, err := client.Do(ctx, req, codeOwners)
+	if err != nil {
+		return nil, resp, err
+	}
+	return codeOwners, resp, nil
+}
+
+func DeleteProjectCodeOwners(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/codeowners/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
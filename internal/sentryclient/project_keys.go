@@ -0,0 +1,41 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// ProjectKeyDynamicSdkLoaderOptionsParams sets the features of the JavaScript
+// Loader Script of a client key. Unset fields are left as they are.
+type ProjectKeyDynamicSdkLoaderOptionsParams struct {
+	HasReplay      *bool `json:"hasReplay,omitempty"`
+	HasPerformance *bool `json:"hasPerformance,omitempty"`
+	HasDebug       *bool `json:"hasDebug,omitempty"`
+}
+
+// UpdateProjectKeyParams extends sentry.UpdateProjectKeyParams with the
+// settings that go-sentry does not send.
+type UpdateProjectKeyParams struct {
+	Name                    string                                   `json:"name,omitempty"`
+	RateLimit               *sentry.ProjectKeyRateLimit              `json:"rateLimit,omitempty"`
+	IsActive                *bool                                    `json:"isActive,omitempty"`
+	BrowserSdkVersion       *string                                  `json:"browserSdkVersion,omitempty"`
+	DynamicSdkLoaderOptions *ProjectKeyDynamicSdkLoaderOptionsParams `json:"dynamicSdkLoaderOptions,omitempty"`
+}
+
+func UpdateProjectKey(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, keyID string, params *UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/keys/%v/", organizationSlug, projectSlug, keyID)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectKey := new(sentry.ProjectKey)
+	resp, err := client.Do(ctx, req, projectKey)
+	if err != nil {
+		return nil, resp, err
+	}
+	return projectKey, resp, nil
+}
@@ -0,0 +1,33 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// UpdateProjectOwnershipParams updates the ownership settings of a project.
+// Unlike the go-sentry equivalent, Raw is sent when empty so that the rules can
+// be cleared.
+type UpdateProjectOwnershipParams struct {
+	Raw                *string `json:"raw,omitempty"`
+	FallThrough        *bool   `json:"fallthrough,omitempty"`
+	AutoAssignment     *string `json:"autoAssignment,omitempty"`
+	CodeownersAutoSync *bool   `json:"codeownersAutoSync,omitempty"`
+}
+
+func UpdateProjectOwnership(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, params *UpdateProjectOwnershipParams) (*sentry.ProjectOwnership, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/ownership/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownership := new(sentry.ProjectOwnership)
+	resp, err := client.Do(ctx, req, ownership)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ownership, resp, nil
+}
@@ -0,0 +1,89 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// Keys of the project options sent in the `options` field of a project update.
+const (
+	ProjectOptionScrubData         = "sentry:scrub_data"
+	ProjectOptionSensitiveFields   = "sentry:sensitive_fields"
+	ProjectOptionSafeFields        = "sentry:safe_fields"
+	ProjectOptionScrubIPAddress    = "sentry:scrub_ip_address"
+	ProjectOptionStoreCrashReports = "sentry:store_crash_reports"
+	ProjectOptionTokenHeader       = "sentry:token_header"
+
+	// The custom inbound filters are newline-separated lists.
+	ProjectOptionFilterErrorMessages  = "filters:error_messages"
+	ProjectOptionFilterReleases       = "filters:releases"
+	ProjectOptionFilterBlacklistedIPs = "filters:blacklisted_ips"
+)
+
+// ProjectOptions holds the project settings that are returned as top level
+// fields of the project details, some of which go-sentry does not decode.
+type ProjectOptions struct {
+	DataScrubber        bool     `json:"dataScrubber"`
+	SensitiveFields     []string `json:"sensitiveFields"`
+	SafeFields          []string `json:"safeFields"`
+	ScrubIPAddresses    bool     `json:"scrubIPAddresses"`
+	StoreCrashReports   *int     `json:"storeCrashReports"`
+	SubjectPrefix       string   `json:"subjectPrefix"`
+	HighlightTags       []string `json:"highlightTags"`
+	VerifySSL           bool     `json:"verifySSL"`
+	SecurityTokenHeader *string  `json:"securityTokenHeader"`
+	RelayPiiConfig      *string  `json:"relayPiiConfig"`
+
+	// BuiltinSymbolSources holds the `sentry:builtin_symbol_sources` option.
+	BuiltinSymbolSources []string `json:"builtinSymbolSources"`
+
+	// Options holds the raw project options, e.g. the custom inbound filters.
+	Options map[string]interface{} `json:"options"`
+}
+
+// ProjectOptionsParams updates project settings. Only the fields that are set
+// are changed.
+type ProjectOptionsParams struct {
+	Options       map[string]interface{} `json:"options,omitempty"`
+	SubjectPrefix *string                `json:"subjectPrefix,omitempty"`
+	HighlightTags *[]string              `json:"highlightTags,omitempty"`
+	VerifySSL     *bool                  `json:"verifySSL,omitempty"`
+
+	BuiltinSymbolSources *[]string `json:"builtinSymbolSources,omitempty"`
+
+	// RelayPiiConfig is sent when empty so that the advanced data scrubbing
+	// rules can be cleared.
+	RelayPiiConfig *string `json:"relayPiiConfig,omitempty"`
+}
+
+func GetProjectOptions(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string) (*ProjectOptions, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := new(ProjectOptions)
+	resp, err := client.Do(ctx, req, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return options, resp, nil
+}
+
+func UpdateProjectOptions(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, params *ProjectOptionsParams) (*ProjectOptions, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := new(ProjectOptions)
+	resp, err := client.Do(ctx, req, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	return options, resp, nil
+}
@@ -0,0 +1,100 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+type ReleaseThreshold struct {
+	ID              string                       `json:"id"`
+	ThresholdType   string                       `json:"threshold_type"`
+	TriggerType     string                       `json:"trigger_type"`
+	Value           int64                        `json:"value"`
+	WindowInSeconds int64                        `json:"window_in_seconds"`
+	Environment     *ReleaseThresholdEnvironment `json:"environment"`
+}
+
+type ReleaseThresholdEnvironment struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ReleaseThresholdParams struct {
+	ThresholdType   string  `json:"threshold_type"`
+	TriggerType     string  `json:"trigger_type"`
+	Value           int64   `json:"value"`
+	WindowInSeconds int64   `json:"window_in_seconds"`
+	Environment     *string `json:"environment,omitempty"`
+}
+
+func ListReleaseThresholds(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string) ([]*ReleaseThreshold, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/release-thresholds/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var thresholds []*ReleaseThreshold
+	resp, err := client.Do(ctx, req, &thresholds)
+	if err != nil {
+		return nil, resp, err
+	}
+	return thresholds, resp, nil
+}
+
+func GetReleaseThreshold(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string) (*ReleaseThreshold, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/release-thresholds/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	threshold := new(ReleaseThreshold)
+	resp, err := client.Do(ctx, req, threshold)
+	if err != nil {
+		return nil, resp, err
+	}
+	return threshold, resp, nil
+}
+
+func CreateReleaseThreshold(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, params *ReleaseThresholdParams) (*ReleaseThreshold, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/release-thresholds/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	threshold := new(ReleaseThreshold)
+	resp, err := client.Do(ctx, req, threshold)
+	if err != nil {
+		return nil, resp, err
+	}
+	return threshold, resp, nil
+}
+
+func UpdateReleaseThreshold(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string, params *ReleaseThresholdParams) (*ReleaseThreshold, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/release-thresholds/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	threshold := new(ReleaseThreshold)
+	resp, err := client.Do(ctx, req, threshold)
+	if err != nil {
+		return nil, resp, err
+	}
+	return threshold, resp, nil
+}
+
+func DeleteReleaseThreshold(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/release-thresholds/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
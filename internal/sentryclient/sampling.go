@@ -0,0 +1,126 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// ProjectSampling holds the dynamic sampling settings of a project.
+type ProjectSampling struct {
+	TargetSampleRate *float64                `json:"targetSampleRate"`
+	DynamicSampling  *ProjectDynamicSampling `json:"dynamicSampling"`
+}
+
+type ProjectDynamicSampling struct {
+	Rules []DynamicSamplingRule `json:"rules"`
+}
+
+// DynamicSamplingRule samples the traces that match its condition at a fixed
+// rate, optionally only within a time range.
+type DynamicSamplingRule struct {
+	ID         int                       `json:"id"`
+	Type       string                    `json:"type"`
+	SampleRate float64                   `json:"sampleRate"`
+	Condition  DynamicSamplingCondition  `json:"condition"`
+	TimeRange  *DynamicSamplingTimeRange `json:"timeRange,omitempty"`
+}
+
+// DynamicSamplingCondition matches when all of its inner conditions match.
+type DynamicSamplingCondition struct {
+	Op    string                          `json:"op"`
+	Inner []DynamicSamplingInnerCondition `json:"inner"`
+}
+
+// DynamicSamplingInnerCondition matches when the field called Name matches one
+// of the glob patterns in Value.
+type DynamicSamplingInnerCondition struct {
+	Op    string   `json:"op"`
+	Name  string   `json:"name"`
+	Value []string `json:"value"`
+}
+
+type DynamicSamplingTimeRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ProjectSamplingParams updates the dynamic sampling settings of a project.
+// Only the fields that are set are changed.
+type ProjectSamplingParams struct {
+	TargetSampleRate *float64                `json:"targetSampleRate,omitempty"`
+	DynamicSampling  *ProjectDynamicSampling `json:"dynamicSampling,omitempty"`
+}
+
+func GetProjectSampling(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string) (*ProjectSampling, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sampling := new(ProjectSampling)
+	resp, err := client.Do(ctx, req, sampling)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sampling, resp, nil
+}
+
+func UpdateProjectSampling(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, params *ProjectSamplingParams) (*ProjectSampling, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sampling := new(ProjectSampling)
+	resp, err := client.Do(ctx, req, sampling)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sampling, resp, nil
+}
+
+// OrganizationSampling holds the dynamic sampling settings of an organization.
+// The target sample rate applies to all projects when the sampling mode is
+// `organization`.
+type OrganizationSampling struct {
+	SamplingMode     *string  `json:"samplingMode"`
+	TargetSampleRate *float64 `json:"targetSampleRate"`
+}
+
+type OrganizationSamplingParams struct {
+	TargetSampleRate *float64 `json:"targetSampleRate,omitempty"`
+}
+
+func GetOrganizationSampling(ctx context.Context, client *sentry.Client, organizationSlug string) (*OrganizationSampling, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/", organizationSlug)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sampling := new(OrganizationSampling)
+	resp, err := client.Do(ctx, req, sampling)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sampling, resp, nil
+}
+
+func UpdateOrganizationSampling(ctx context.Context, client *sentry.Client, organizationSlug string, params *OrganizationSamplingParams) (*OrganizationSampling, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/", organizationSlug)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sampling := new(OrganizationSampling)
+	resp, err := client.Do(ctx, req, sampling)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sampling, resp, nil
+}
@@ -0,0 +1,81 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// ServiceHook is a legacy project service hook, which posts events of a
+// project to a URL.
+type ServiceHook struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Status string   `json:"status"`
+	Events []string `json:"events"`
+}
+
+type ServiceHookParams struct {
+	URL      string   `json:"url"`
+	Events   []string `json:"events"`
+	IsActive *bool    `json:"isActive,omitempty"`
+}
+
+func GetServiceHook(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string) (*ServiceHook, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/hooks/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(ServiceHook)
+	resp, err := client.Do(ctx, req, hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+// CreateServiceHook creates an active service hook. The status of the new
+// hook can only be changed with UpdateServiceHook.
+func CreateServiceHook(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, params *ServiceHookParams) (*ServiceHook, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/hooks/", organizationSlug, projectSlug)
+	req, err := client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(ServiceHook)
+	resp, err := client.Do(ctx, req, hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+func UpdateServiceHook(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string, params *ServiceHookParams) (*ServiceHook, *sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/hooks/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(ServiceHook)
+	resp, err := client.Do(ctx, req, hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+func DeleteServiceHook(ctx context.Context, client *sentry.Client, organizationSlug string, projectSlug string, id string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/projects/%v/%v/hooks/%v/", organizationSlug, projectSlug, id)
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
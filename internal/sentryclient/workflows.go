@@ -0,0 +1,283 @@
+package sentryclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// Actor is a team or user reference returned by the workflow engine APIs,
+// either as `team:<id>` / `user:<id>` or as an object.
+type Actor string
+
+func (a *Actor) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = Actor(s)
+		return nil
+	}
+
+	var v struct {
+		Type string      `json:"type"`
+		ID   json.Number `json:"id"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*a = Actor(v.Type + ":" + v.ID.String())
+	return nil
+}
+
+type DataCondition struct {
+	ID              *string         `json:"id,omitempty"`
+	Type            string          `json:"type"`
+	Comparison      json.RawMessage `json:"comparison"`
+	ConditionResult json.RawMessage `json:"conditionResult"`
+}
+
+type WorkflowAction struct {
+	ID            *string         `json:"id,omitempty"`
+	Type          string          `json:"type"`
+	IntegrationID *string         `json:"integrationId,omitempty"`
+	Data          json.RawMessage `json:"data"`
+	Config        json.RawMessage `json:"config"`
+}
+
+type DataConditionGroup struct {
+	ID         *string          `json:"id,omitempty"`
+	LogicType  string           `json:"logicType"`
+	Conditions []DataCondition  `json:"conditions"`
+	Actions    []WorkflowAction `json:"actions,omitempty"`
+}
+
+type DetectorSnubaQuery struct {
+	Query       string   `json:"query"`
+	Aggregate   string   `json:"aggregate"`
+	TimeWindow  int64    `json:"timeWindow"`
+	Environment *string  `json:"environment,omitempty"`
+	Dataset     string   `json:"dataset"`
+	EventTypes  []string `json:"eventTypes,omitempty"`
+}
+
+type DetectorDataSource struct {
+	ID       *string `json:"id,omitempty"`
+	Type     string  `json:"type"`
+	QueryObj struct {
+		SnubaQuery DetectorSnubaQuery `json:"snubaQuery"`
+	} `json:"queryObj"`
+}
+
+type DetectorConfig struct {
+	DetectionType   string `json:"detectionType"`
+	ComparisonDelta *int64 `json:"comparisonDelta,omitempty"`
+}
+
+type Detector struct {
+	ID             *string              `json:"id,omitempty"`
+	ProjectID      string               `json:"projectId"`
+	Name           string               `json:"name"`
+	Type           string               `json:"type"`
+	Enabled        bool                 `json:"enabled"`
+	Owner          *Actor               `json:"owner,omitempty"`
+	Config         DetectorConfig       `json:"config"`
+	DataSources    []DetectorDataSource `json:"dataSources,omitempty"`
+	ConditionGroup *DataConditionGroup  `json:"conditionGroup,omitempty"`
+}
+
+// DetectorParams is the request body for creating or updating a detector. The
+// data source is sent as a single query, but returned as a list of sources.
+type DetectorParams struct {
+	ProjectID      string              `json:"projectId"`
+	Name           string              `json:"name"`
+	Type           string              `json:"type"`
+	Enabled        bool                `json:"enabled"`
+	Owner          *string             `json:"owner"`
+	Config         DetectorConfig      `json:"config"`
+	DataSource     DetectorSnubaQuery  `json:"dataSource"`
+	ConditionGroup *DataConditionGroup `json:"conditionGroup"`
+}
+
+type WorkflowConfig struct {
+	Frequency *int64 `json:"frequency,omitempty"`
+}
+
+type Workflow struct {
+	ID            *string              `json:"id,omitempty"`
+	Name          string               `json:"name"`
+	Enabled       bool                 `json:"enabled"`
+	Environment   *string              `json:"environment"`
+	Config        WorkflowConfig       `json:"config"`
+	Triggers      *DataConditionGroup  `json:"triggers"`
+	ActionFilters []DataConditionGroup `json:"actionFilters"`
+	DetectorIDs   []string             `json:"detectorIds"`
+}
+
+func (w *Workflow) UnmarshalJSON(data []byte) error {
+	// Detector IDs are returned as strings or numbers depending on the
+	// Sentry version.
+	type workflow Workflow
+	var v struct {
+		workflow
+		DetectorIDs []json.Number `json:"detectorIds"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*w = Workflow(v.workflow)
+	w.DetectorIDs = make([]string, 0, len(v.DetectorIDs))
+	for _, id := range v.DetectorIDs {
+		w.DetectorIDs = append(w.DetectorIDs, id.String())
+	}
+	return nil
+}
+
+func GetDetector(ctx context.Context, client *sentry.Client, organizationSlug string, id string) (*Detector, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/detectors/%v/", organizationSlug, id)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	detector := new(Detector)
+	resp, err := client.Do(ctx, req, detector)
+	if err != nil {
+		return nil, resp, err
+	}
+	return detector, resp, nil
+}
+
+func CreateDetector(ctx context.Context, client *sentry.Client, organizationSlug string, params *DetectorParams) (*Detector, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/detectors/", organizationSlug)
+	req, err := client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	detector := new(Detector)
+	resp, err := client.Do(ctx, req, detector)
+	if err != nil {
+		return nil, resp, err
+	}
+	return detector, resp, nil
+}
+
+func UpdateDetector(ctx context.Context, client *sentry.Client, organizationSlug string, id string, params *DetectorParams) (*Detector, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/detectors/%v/", organizationSlug, id)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	detector := new(Detector)
+	resp, err := client.Do(ctx, req, detector)
+	if err != nil {
+		return nil, resp, err
+	}
+	return detector, resp, nil
+}
+
+func DeleteDetector(ctx context.Context, client *sentry.Client, organizationSlug string, id string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/detectors/%v/", organizationSlug, id)
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+func GetWorkflow(ctx context.Context, client *sentry.Client, organizationSlug string, id string) (*Workflow, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/workflows/%v/", organizationSlug, id)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflow := new(Workflow)
+	resp, err := client.Do(ctx, req, workflow)
+	if err != nil {
+		return nil, resp, err
+	}
+	return workflow, resp, nil
+}
+
+func CreateWorkflow(ctx context.Context, client *sentry.Client, organizationSlug string, params *Workflow) (*Workflow, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/workflows/", organizationSlug)
+	req, err := client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflow := new(Workflow)
+	resp, err := client.Do(ctx, req, workflow)
+	if err != nil {
+		return nil, resp, err
+	}
+	return workflow, resp, nil
+}
+
+func UpdateWorkflow(ctx context.Context, client *sentry.Client, organizationSlug string, id string, params *Workflow) (*Workflow, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/workflows/%v/", organizationSlug, id)
+	req, err := client.NewRequest("PUT", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflow := new(Workflow)
+	resp, err := client.Do(ctx, req, workflow)
+	if err != nil {
+		return nil, resp, err
+	}
+	return workflow, resp, nil
+}
+
+func DeleteWorkflow(ctx context.Context, client *sentry.Client, organizationSlug string, id string) (*sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/workflows/%v/", organizationSlug, id)
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// GetWorkflowIDForIssueAlert returns the ID of the workflow Sentry migrated
+// the issue alert rule to.
+func GetWorkflowIDForIssueAlert(ctx context.Context, client *sentry.Client, organizationSlug string, ruleID string) (string, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/alert-rule-workflow/?rule_id=%v", organizationSlug, ruleID)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var v struct {
+		WorkflowID json.Number `json:"workflowId"`
+	}
+	resp, err := client.Do(ctx, req, &v)
+	if err != nil {
+		return "", resp, err
+	}
+	return v.WorkflowID.String(), resp, nil
+}
+
+// GetDetectorIDForMetricAlert returns the ID of the detector Sentry migrated
+// the metric alert rule to.
+func GetDetectorIDForMetricAlert(ctx context.Context, client *sentry.Client, organizationSlug string, alertRuleID string) (string, *sentry.Response, error) {
+	u := fmt.Sprintf("0/organizations/%v/alert-rule-detector/?alert_rule_id=%v", organizationSlug, alertRuleID)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var v struct {
+		DetectorID json.Number `json:"detectorId"`
+	}
+	resp, err := client.Do(ctx, req, &v)
+	if err != nil {
+		return "", resp, err
+	}
+	return v.DetectorID.String(), resp, nil
+}
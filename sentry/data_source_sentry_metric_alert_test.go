@@ -72,7 +72,7 @@ func TestAccSentryMetricAlertDataSource_basic(t *testing.T) {
 }
 
 func testAccSentryMetricAlertDataSourceConfig(teamName, projectName, alertName string) string {
-	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+	return testAccSentryProjectConfig(teamName, projectName) + fmt.Sprintf(`
 resource "sentry_metric_alert" "test" {
 	organization      = sentry_project.test.organization
 	project           = sentry_project.test.id
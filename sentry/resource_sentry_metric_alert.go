@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-multierror"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
 	"github.com/jianyuan/go-sentry/v2/sentry"
 )
 
@@ -25,7 +27,7 @@ func resourceSentryMetricAlert() *schema.Resource {
 		DeleteContext: resourceSentryMetricAlertDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importMetricAlert,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -173,7 +175,7 @@ func resourceSentryMetricAlert() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Specifies the owner id of this Alert rule",
+				Description: "The team or user that owns the rule. Either `team:<id>`, `team:<slug>`, `user:<id>`, or `user:<email>`. Slugs and emails are resolved to IDs and kept in the configured form.",
 			},
 			"internal_id": {
 				Description: "The internal ID for this metric alert.",
@@ -184,6 +186,32 @@ func resourceSentryMetricAlert() *schema.Resource {
 	}
 }
 
+// importMetricAlert accepts either `organization/project/alert-id` or
+// `organization/project/name:<rule name>`.
+func importMetricAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*sentry.Client)
+
+	org, project, alertRef, err := splitSentryAlertID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := strings.CutPrefix(alertRef, sentryclient.AlertNamePrefix); ok {
+		tflog.Debug(ctx, "Finding metric alert by name", map[string]interface{}{
+			"org":     org,
+			"project": project,
+			"name":    name,
+		})
+		alert, err := sentryclient.FindMetricAlertByName(ctx, client, org, project, name)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceSentryMetricAlertObject(d *schema.ResourceData) (*sentry.MetricAlert, error) {
 	alert := &sentry.MetricAlert{
 		Name:          sentry.String(d.Get("name").(string)),
@@ -243,6 +271,13 @@ func resourceSentryMetricAlertCreate(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if alertReq.Owner != nil {
+		owner, err := sentryclient.ResolveOwner(ctx, client, org, *alertReq.Owner)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		alertReq.Owner = sentry.String(owner)
+	}
 
 	tflog.Info(ctx, "Creating metric alert", map[string]interface{}{
 		"org":      org,
@@ -256,7 +291,37 @@ func resourceSentryMetricAlertCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
-	return resourceSentryMetricAlertRead(ctx, d, meta)
+	diags := metricAlertEnvironmentWarnings(ctx, client, org, project, d)
+	return append(diags, resourceSentryMetricAlertRead(ctx, d, meta)...)
+}
+
+// metricAlertEnvironmentWarnings warns about an environment that has not been
+// seen in the project, which is usually a typo. The SDK cannot report warnings
+// during plan, so the warning is reported on apply.
+func metricAlertEnvironmentWarnings(ctx context.Context, client *sentry.Client, org string, project string, d *schema.ResourceData) diag.Diagnostics {
+	environment, ok := d.GetOk("environment")
+	if !ok || !d.HasChange("environment") {
+		return nil
+	}
+
+	warning, err := sentryclient.UnknownEnvironmentWarning(ctx, client, org, project, environment.(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "Unable to Validate Environment",
+			Detail:        fmt.Sprintf("The environments of project %s could not be listed, so %q was not checked: %s", project, environment.(string), err.Error()),
+			AttributePath: cty.GetAttrPath("environment"),
+		}}
+	}
+	if warning == "" {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "Unknown Environment",
+		Detail:        warning,
+		AttributePath: cty.GetAttrPath("environment"),
+	}}
 }
 
 func resourceSentryMetricAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -287,6 +352,19 @@ func resourceSentryMetricAlertRead(ctx context.Context, d *schema.ResourceData,
 		"alert": fmt.Sprintf("%+v", alert),
 	})
 
+	owner := alert.Owner
+	if v, ok := d.GetOk("owner"); ok && owner != nil {
+		// Keep the owner in the configured form, e.g. `team:<slug>`, as long
+		// as it still refers to the same team or user.
+		equal, err := sentryclient.OwnerEquals(ctx, client, org, v.(string), *owner)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if equal {
+			owner = sentry.String(v.(string))
+		}
+	}
+
 	d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
 	retError := multierror.Append(
 		d.Set("organization", org),
@@ -301,7 +379,7 @@ func resourceSentryMetricAlertRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("resolve_threshold", alert.ResolveThreshold),
 		d.Set("comparison_delta", alert.ComparisonDelta),
 		d.Set("trigger", flattenMetricAlertTriggers(alert.Triggers)),
-		d.Set("owner", alert.Owner),
+		d.Set("owner", owner),
 		d.Set("internal_id", alert.ID),
 	)
 	if len(alert.Projects) == 1 {
@@ -324,6 +402,13 @@ func resourceSentryMetricAlertUpdate(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if alertReq.Owner != nil {
+		owner, err := sentryclient.ResolveOwner(ctx, client, org, *alertReq.Owner)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		alertReq.Owner = sentry.String(owner)
+	}
 
 	tflog.Debug(ctx, "Updating metric alert", map[string]interface{}{
 		"org":     org,
@@ -336,7 +421,8 @@ func resourceSentryMetricAlertUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.SetId(buildThreePartID(org, project, sentry.StringValue(alert.ID)))
-	return resourceSentryMetricAlertRead(ctx, d, meta)
+	diags := metricAlertEnvironmentWarnings(ctx, client, org, project, d)
+	return append(diags, resourceSentryMetricAlertRead(ctx, d, meta)...)
 }
 
 func resourceSentryMetricAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
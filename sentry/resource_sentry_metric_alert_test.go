@@ -60,6 +60,12 @@ func TestAccSentryMetricAlert_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     buildThreePartID(acctest.TestOrganization, projectName, "name:"+alertName+"-renamed"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -117,7 +123,7 @@ func testAccCheckSentryMetricAlertExists(n string, gotAlertID *string) resource.
 }
 
 func testAccSentryMetricAlertConfig(teamName, projectName, alertName string) string {
-	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+	return testAccSentryProjectConfig(teamName, projectName) + fmt.Sprintf(`
 resource "sentry_metric_alert" "test" {
 	organization      = sentry_project.test.organization
 	project           = sentry_project.test.id
@@ -8,7 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/canva/terraform-provider-sentry/internal/sentryclient"
 	"github.com/jianyuan/go-sentry/v2/sentry"
 )
 
@@ -46,6 +48,13 @@ func resourceSentryOrganization() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"target_sample_rate": {
+				Description:  "The target sample rate of the organization's dynamic sampling, between `0` and `1`. Only used when the sampling mode of the organization is `organization`, otherwise see `sentry_project_sampling`. Left as is if not set.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
 		},
 	}
 }
@@ -68,9 +77,33 @@ func resourceSentryOrganizationCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(sentry.StringValue(organization.Slug))
+
+	if err := updateOrganizationSampling(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceSentryOrganizationRead(ctx, d, meta)
 }
 
+// updateOrganizationSampling sets the target sample rate, which go-sentry does
+// not support, if it is configured.
+func updateOrganizationSampling(ctx context.Context, client *sentry.Client, d *schema.ResourceData) error {
+	// GetOk treats 0 as unset, so check the configuration instead. On create,
+	// HasChange is false for 0 as it equals the zero value of the empty state.
+	if d.GetRawConfig().GetAttr("target_sample_rate").IsNull() {
+		return nil
+	}
+	if !d.IsNewResource() && !d.HasChange("target_sample_rate") {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Updating organization target sample rate", map[string]interface{}{"org": d.Id()})
+	_, _, err := sentryclient.UpdateOrganizationSampling(ctx, client, d.Id(), &sentryclient.OrganizationSamplingParams{
+		TargetSampleRate: sentry.Float64(d.Get("target_sample_rate").(float64)),
+	})
+	return err
+}
+
 func resourceSentryOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*sentry.Client)
 	org := d.Id()
@@ -88,11 +121,17 @@ func resourceSentryOrganizationRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	sampling, _, err := sentryclient.GetOrganizationSampling(ctx, client, org)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	retErr := multierror.Append(
 		d.Set("name", organization.Name),
 		d.Set("slug", organization.Slug),
 		d.Set("agree_terms", true),
 		d.Set("internal_id", organization.ID),
+		d.Set("target_sample_rate", sampling.TargetSampleRate),
 	)
 	return diag.FromErr(retErr.ErrorOrNil())
 }
@@ -114,6 +153,11 @@ func resourceSentryOrganizationUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(sentry.StringValue(organization.Slug))
+
+	if err := updateOrganizationSampling(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceSentryOrganizationRead(ctx, d, meta)
 }
 
@@ -89,7 +89,7 @@ func testFilterConfig(n string, filterConfig *sentry.FilterConfig, browserExtens
 }
 
 func testAccSentryProjectFilterConfig(teamName string, projectName string) string {
-	return testAccSentryProjectConfig_team(teamName, projectName) + fmt.Sprintf(`
+	return testAccSentryProjectConfig(teamName, projectName) + fmt.Sprintf(`
 resource "sentry_filter" "test_filter" {
 	organization = "%s"
 	project = sentry_project.test.id
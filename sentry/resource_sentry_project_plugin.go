@@ -13,7 +13,7 @@ import (
 
 func resourceSentryPlugin() *schema.Resource {
 	return &schema.Resource{
-		Description: "Sentry Plugin resource.",
+		Description: "Sentry Plugin resource. Use `sentry_project_data_forwarding` to configure the Segment, Splunk, Amazon SQS and webhooks plugins.",
 
 		CreateContext: resourceSentryPluginCreate,
 		ReadContext:   resourceSentryPluginRead,